@@ -0,0 +1,406 @@
+package answer
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jimschubert/answer/confirm"
+	"github.com/jimschubert/answer/input"
+	"github.com/jimschubert/answer/outcome"
+	"github.com/jimschubert/answer/password"
+	"github.com/jimschubert/answer/rank"
+	"github.com/jimschubert/answer/selection"
+)
+
+var (
+	_ tea.Model = (*Model)(nil)
+)
+
+// ErrCancelled is returned when the user aborts the questionnaire before all questions are answered.
+// This is the same sentinel as outcome.ErrCancelled, allowing callers to check cancellation uniformly.
+var ErrCancelled = outcome.ErrCancelled
+
+// Question associates a unique name with the bubble which asks it.
+type Question struct {
+	// Name is the key under which the answer is collected
+	Name string
+
+	// Prompt is the bubble asking the question, typically one of *input.Model, *selection.Model or *confirm.Model.
+	// The bubble must quit (via tea.Quit) once the user has answered.
+	Prompt tea.Model
+
+	// When optionally determines whether the question is asked, based on previously collected answers.
+	// Questions for which this returns false are skipped, and reported with Answer.Skipped.
+	When func(answers Answers) bool
+}
+
+// Answer holds the result of a single question
+type Answer struct {
+	// Value is the text entered for input questions, or the decision text for confirm questions
+	Value string
+
+	// Values holds the selected values of selection questions
+	Values []string
+
+	// Indexes holds the selected indexes of selection questions
+	Indexes []int
+
+	// Decision holds the decision of confirm questions
+	Decision confirm.Decision
+
+	// Skipped indicates the question was not asked because its Question.When returned false
+	Skipped bool
+
+	// Secret indicates the Value was entered via a password question, which is not recorded unless opted in via
+	// WithRecordingSecrets
+	Secret bool
+}
+
+// Answers holds all collected answers, keyed by Question.Name
+type Answers map[string]Answer
+
+// KeyMap defines the key bindings handled by the questionnaire rather than the active question
+type KeyMap struct {
+	Interrupt key.Binding
+	Back      key.Binding
+}
+
+var DefaultKeyMap = KeyMap{
+	Interrupt: key.NewBinding(key.WithKeys(tea.KeyCtrlC.String())),
+	Back: key.NewBinding(
+		key.WithKeys(tea.KeyShiftUp.String()),
+		key.WithHelp("shift+↑", "previous question"),
+	),
+}
+
+// outcomer is implemented by bubbles which distinguish submission from cancellation
+type outcomer interface {
+	Outcome() outcome.Outcome
+}
+
+// reopener is implemented by bubbles which can be edited again after submission without losing state
+type reopener interface {
+	Reopen()
+}
+
+// questionDone is sent in place of tea.QuitMsg when the question at index has been answered
+type questionDone struct {
+	index int
+}
+
+// Model represents the bubble tea model for a questionnaire, asking each question in order
+type Model struct {
+	Questions []Question
+	KeyMap    KeyMap
+	answers   Answers
+	views     []string
+	started   []bool
+	current   int
+	size      *tea.WindowSizeMsg
+	err       error
+	done      bool
+}
+
+// New creates a new model with default settings.
+func New() Model {
+	return Model{
+		KeyMap: DefaultKeyMap,
+	}
+}
+
+// Answers retrieves the answers collected so far
+func (m *Model) Answers() Answers {
+	return m.answers
+}
+
+// Err returns the reason the questionnaire stopped early, if any
+func (m *Model) Err() error {
+	return m.err
+}
+
+func (m *Model) validate() error {
+	seen := make(map[string]struct{}, len(m.Questions))
+	for i, q := range m.Questions {
+		if q.Name == "" {
+			return fmt.Errorf("question at index %d has no name", i)
+		}
+		if q.Prompt == nil {
+			return fmt.Errorf("question %q has no prompt", q.Name)
+		}
+		if _, ok := seen[q.Name]; ok {
+			return fmt.Errorf("question %q is defined more than once", q.Name)
+		}
+		seen[q.Name] = struct{}{}
+	}
+	return nil
+}
+
+// reset prepares the model to ask all questions from the beginning
+func (m *Model) reset() error {
+	m.answers = make(Answers, len(m.Questions))
+	m.views = make([]string, 0, len(m.Questions))
+	m.started = make([]bool, len(m.Questions))
+	m.current = 0
+	m.done = false
+	m.err = m.validate()
+	if m.err != nil {
+		m.done = true
+	}
+	return m.err
+}
+
+// Init satisfies the tea.Model interface
+func (m *Model) Init() tea.Cmd {
+	if err := m.reset(); err != nil {
+		return tea.Quit
+	}
+	return m.activate()
+}
+
+// activate initializes the current question, or quits when there are no more questions to ask
+func (m *Model) activate() tea.Cmd {
+	for m.current < len(m.Questions) {
+		q := m.Questions[m.current]
+		if q.When == nil || q.When(m.answers) {
+			break
+		}
+		m.answers[q.Name] = Answer{Skipped: true}
+		m.views = append(m.views, "")
+		m.current++
+	}
+
+	if m.current >= len(m.Questions) {
+		m.done = true
+		return tea.Quit
+	}
+
+	var cmds []tea.Cmd
+	prompt := m.Questions[m.current].Prompt
+	if r, ok := prompt.(reopener); ok && m.started[m.current] {
+		// revisited questions retain their state so that the user may confirm or edit their previous answer
+		r.Reopen()
+	} else {
+		cmds = append(cmds, m.intercept(prompt.Init()))
+		m.started[m.current] = true
+	}
+	if m.size != nil {
+		// questions activated after startup have not seen the terminal size
+		_, cmd := prompt.Update(*m.size)
+		cmds = append(cmds, m.intercept(cmd))
+	}
+	return tea.Batch(cmds...)
+}
+
+// previous returns the index of the closest preceding question which was asked and can be reopened, or -1
+func (m *Model) previous() int {
+	for i := m.current - 1; i >= 0; i-- {
+		q := m.Questions[i]
+		if m.answers[q.Name].Skipped {
+			continue
+		}
+		if _, ok := q.Prompt.(reopener); ok {
+			return i
+		}
+	}
+	return -1
+}
+
+// back returns to the question at index, discarding it and all later answers so that they are asked again
+func (m *Model) back(index int) tea.Cmd {
+	for i := index; i < len(m.Questions); i++ {
+		delete(m.answers, m.Questions[i].Name)
+	}
+	m.views = m.views[:index]
+	m.current = index
+	return m.activate()
+}
+
+// intercept wraps a question's command so that quitting only completes the question rather than the whole program
+func (m *Model) intercept(cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	index := m.current
+	var wrap func(cmd tea.Cmd) tea.Cmd
+	wrap = func(cmd tea.Cmd) tea.Cmd {
+		if cmd == nil {
+			return nil
+		}
+		return func() tea.Msg {
+			switch msg := cmd().(type) {
+			case tea.QuitMsg:
+				return questionDone{index: index}
+			case tea.BatchMsg:
+				wrapped := make(tea.BatchMsg, 0, len(msg))
+				for _, c := range msg {
+					wrapped = append(wrapped, wrap(c))
+				}
+				return wrapped
+			default:
+				return msg
+			}
+		}
+	}
+	return wrap(cmd)
+}
+
+// Update satisfies the tea.Model interface
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if m.done {
+		return m, nil
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.KeyMap.Interrupt):
+			m.err = ErrCancelled
+			m.done = true
+			return m, tea.Quit
+		case key.Matches(msg, m.KeyMap.Back):
+			if index := m.previous(); index >= 0 {
+				return m, m.back(index)
+			}
+			return m, nil
+		}
+	case tea.WindowSizeMsg:
+		m.size = &msg
+	case questionDone:
+		if msg.index != m.current {
+			// the question has already been completed
+			return m, nil
+		}
+		q := m.Questions[m.current]
+		if o, ok := q.Prompt.(outcomer); ok && o.Outcome() == outcome.Cancelled {
+			m.err = ErrCancelled
+			m.done = true
+			return m, tea.Quit
+		}
+		m.answers[q.Name] = answerOf(q.Prompt)
+		m.views = append(m.views, q.Prompt.View())
+		m.current++
+		return m, m.activate()
+	}
+
+	_, cmd := m.Questions[m.current].Prompt.Update(msg)
+	return m, m.intercept(cmd)
+}
+
+// View satisfies the tea.Model interface
+func (m *Model) View() string {
+	var b strings.Builder
+	for _, view := range m.views {
+		b.WriteString(view)
+	}
+	if !m.done && m.current < len(m.Questions) {
+		b.WriteString(m.Questions[m.current].Prompt.View())
+	}
+	return b.String()
+}
+
+// answerOf extracts the answer from a completed question
+func answerOf(prompt tea.Model) Answer {
+	switch p := prompt.(type) {
+	case *input.Model:
+		return Answer{Value: p.Value()}
+	case *selection.Model:
+		return Answer{Values: p.SelectedValues(), Indexes: p.SelectedIndexes()}
+	case *confirm.Model:
+		return Answer{Value: p.Value(), Decision: p.Selected()}
+	case *rank.Model:
+		return Answer{Values: p.Values(), Indexes: p.Indexes()}
+	case *password.Model:
+		return Answer{Value: p.Value(), Secret: true}
+	case interface{ SelectedValues() []string }:
+		return Answer{Values: p.SelectedValues()}
+	case interface{ Value() string }:
+		return Answer{Value: p.Value()}
+	}
+	return Answer{}
+}
+
+type askOpts struct {
+	programOptions []tea.ProgramOption
+	sources        []Source
+	nonInteractive bool
+	detectTerminal bool
+	recordPath     string
+	recordSecrets  bool
+	replayPath     string
+}
+
+// AskOpt is a set of options for use with Ask
+type AskOpt func(o *askOpts)
+
+// WithProgramOptions returns an AskOpt which passes the provided options to the underlying tea.Program.
+func WithProgramOptions(options ...tea.ProgramOption) AskOpt {
+	return func(o *askOpts) {
+		o.programOptions = append(o.programOptions, options...)
+	}
+}
+
+// WithSources returns an AskOpt which defines the sources used to resolve answers when asking non-interactively.
+// Sources are consulted in the order provided. See Model.Resolve.
+func WithSources(sources ...Source) AskOpt {
+	return func(o *askOpts) {
+		o.sources = append(o.sources, sources...)
+	}
+}
+
+// WithNonInteractive returns an AskOpt which, when enabled, resolves all answers from sources rather than the user.
+func WithNonInteractive(enabled bool) AskOpt {
+	return func(o *askOpts) {
+		o.nonInteractive = enabled
+	}
+}
+
+// WithTerminalDetection returns an AskOpt which, when enabled, resolves all answers from sources rather than the user
+// if stdin is not a terminal (e.g. in CI pipelines or when input is piped).
+func WithTerminalDetection(enabled bool) AskOpt {
+	return func(o *askOpts) {
+		o.detectTerminal = enabled
+	}
+}
+
+// Ask runs each of the questions in order within a single bubble tea program, returning all answers keyed by Question.Name
+func Ask(questions []Question, options ...AskOpt) (Answers, error) {
+	opts := askOpts{}
+	for _, opt := range options {
+		opt(&opts)
+	}
+
+	sources := opts.sources
+	if opts.replayPath != "" {
+		replay, err := LoadFile(opts.replayPath)
+		if err != nil {
+			return nil, err
+		}
+		sources = append([]Source{replay}, sources...)
+	}
+
+	m := New()
+	m.Questions = questions
+	if opts.nonInteractive || (opts.detectTerminal && !isTerminal(os.Stdin)) {
+		if err := m.Resolve(sources...); err != nil {
+			return m.Answers(), err
+		}
+	} else {
+		p := tea.NewProgram(&m, opts.programOptions...)
+		if _, err := p.Run(); err != nil {
+			return nil, err
+		}
+		if err := m.Err(); err != nil {
+			return m.Answers(), err
+		}
+	}
+
+	if opts.recordPath != "" {
+		if err := m.Answers().save(opts.recordPath, opts.recordSecrets); err != nil {
+			return m.Answers(), err
+		}
+	}
+	return m.Answers(), nil
+}
@@ -0,0 +1,274 @@
+package answer
+
+import (
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+	"github.com/jimschubert/answer/confirm"
+	"github.com/jimschubert/answer/input"
+	"github.com/jimschubert/answer/selection"
+	"github.com/jimschubert/stripansi"
+	"github.com/stretchr/testify/assert"
+)
+
+type state struct {
+	Name          string
+	Inputs        []tea.Msg
+	ExpectView    string
+	ExpectErr     error
+	ExpectAnswers Answers
+}
+
+func newQuestions() []Question {
+	name := input.New()
+	name.Prompt = "What is your name?"
+
+	color := selection.New()
+	color.Prompt = "Choose a color:"
+	color.Choices = []string{"Red", "Green", "Blue"}
+
+	pie := confirm.New()
+	pie.Prompt = "Do you like pie?"
+
+	return []Question{
+		{Name: "name", Prompt: &name},
+		{Name: "color", Prompt: &color},
+		{Name: "pie", Prompt: &pie},
+	}
+}
+
+func newConditionalQuestions() []Question {
+	useProxy := confirm.New()
+	useProxy.Prompt = "Use a proxy?"
+
+	proxy := input.New()
+	proxy.Prompt = "Proxy URL:"
+
+	return []Question{
+		{Name: "useProxy", Prompt: &useProxy},
+		{Name: "proxy", Prompt: &proxy, When: func(answers Answers) bool {
+			return answers["useProxy"].Decision == confirm.Accepted
+		}},
+	}
+}
+
+func typed(value string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(value)}
+}
+
+func TestModel_View(t *testing.T) {
+	tests := []struct {
+		name      string
+		questions func() []Question
+		states    []state
+	}{
+		{
+			name:      "questionnaire",
+			questions: newQuestions,
+			states: []state{
+				{
+					Name: "collects all answers",
+					Inputs: []tea.Msg{
+						typed("Jim"),
+						tea.KeyMsg{Type: tea.KeyEnter},
+						tea.KeyMsg{Type: tea.KeyDown},
+						tea.KeyMsg{Type: tea.KeySpace},
+						tea.KeyMsg{Type: tea.KeyEnter},
+						typed("n"),
+						tea.KeyMsg{Type: tea.KeyEnter},
+					},
+					ExpectView: "? What is your name? Jim\r\n? Choose a color:",
+					ExpectAnswers: Answers{
+						"name":  {Value: "Jim"},
+						"color": {Values: []string{"Green"}, Indexes: []int{1}},
+						"pie":   {Value: "n", Decision: confirm.Denied},
+					},
+				},
+				{
+					Name: "returns to previous question",
+					Inputs: []tea.Msg{
+						typed("Jim"),
+						tea.KeyMsg{Type: tea.KeyEnter},
+						tea.KeyMsg{Type: tea.KeyDown},
+						tea.KeyMsg{Type: tea.KeySpace},
+						tea.KeyMsg{Type: tea.KeyShiftUp},
+						tea.KeyMsg{Type: tea.KeyBackspace},
+						tea.KeyMsg{Type: tea.KeyBackspace},
+						typed("ames"),
+						tea.KeyMsg{Type: tea.KeyEnter},
+						tea.KeyMsg{Type: tea.KeyEnter},
+						tea.KeyMsg{Type: tea.KeyEnter},
+					},
+					ExpectView: "? What is your name? James\r\n? Choose a color:",
+					ExpectAnswers: Answers{
+						"name":  {Value: "James"},
+						"color": {Values: []string{"Green"}, Indexes: []int{1}},
+						"pie":   {Value: "y", Decision: confirm.Accepted},
+					},
+				},
+				{
+					Name: "ignores back on the first question",
+					Inputs: []tea.Msg{
+						tea.KeyMsg{Type: tea.KeyShiftUp},
+						typed("Jim"),
+						tea.KeyMsg{Type: tea.KeyEnter},
+						tea.KeyMsg{Type: tea.KeyEnter},
+						tea.KeyMsg{Type: tea.KeyEnter},
+					},
+					ExpectView: "? What is your name? Jim\r\n? Choose a color:",
+					ExpectAnswers: Answers{
+						"name":  {Value: "Jim"},
+						"color": {Values: []string{}, Indexes: []int{}},
+						"pie":   {Value: "y", Decision: confirm.Accepted},
+					},
+				},
+				{
+					Name: "stops when a question is cancelled",
+					Inputs: []tea.Msg{
+						typed("Jim"),
+						tea.KeyMsg{Type: tea.KeyEnter},
+						tea.KeyMsg{Type: tea.KeyEsc},
+					},
+					ExpectView: "? What is your name? Jim\r\n",
+					ExpectErr:  ErrCancelled,
+					ExpectAnswers: Answers{
+						"name": {Value: "Jim"},
+					},
+				},
+				{
+					Name: "stops when interrupted",
+					Inputs: []tea.Msg{
+						typed("Jim"),
+						tea.KeyMsg{Type: tea.KeyEnter},
+						tea.KeyMsg{Type: tea.KeyCtrlC},
+					},
+					ExpectView: "? What is your name? Jim\r\n",
+					ExpectErr:  ErrCancelled,
+					ExpectAnswers: Answers{
+						"name": {Value: "Jim"},
+					},
+				},
+			},
+		},
+		{
+			name:      "conditional questionnaire",
+			questions: newConditionalQuestions,
+			states: []state{
+				{
+					Name: "asks when predicate passes",
+					Inputs: []tea.Msg{
+						typed("y"),
+						tea.KeyMsg{Type: tea.KeyEnter},
+						typed("http://localhost:3128"),
+						tea.KeyMsg{Type: tea.KeyEnter},
+					},
+					ExpectView: "? Use a proxy? y\r\n? Proxy URL: http://localhost:3128",
+					ExpectAnswers: Answers{
+						"useProxy": {Value: "y", Decision: confirm.Accepted},
+						"proxy":    {Value: "http://localhost:3128"},
+					},
+				},
+				{
+					Name: "re-evaluates predicate after returning to previous question",
+					Inputs: []tea.Msg{
+						typed("y"),
+						tea.KeyMsg{Type: tea.KeyEnter},
+						typed("http://localhost:3128"),
+						tea.KeyMsg{Type: tea.KeyShiftUp},
+						tea.KeyMsg{Type: tea.KeyBackspace},
+						typed("n"),
+						tea.KeyMsg{Type: tea.KeyEnter},
+					},
+					ExpectView: "? Use a proxy? n\r\n",
+					ExpectAnswers: Answers{
+						"useProxy": {Value: "n", Decision: confirm.Denied},
+						"proxy":    {Skipped: true},
+					},
+				},
+				{
+					Name: "skips when predicate fails",
+					Inputs: []tea.Msg{
+						typed("n"),
+						tea.KeyMsg{Type: tea.KeyEnter},
+					},
+					ExpectView: "? Use a proxy? n\r\n",
+					ExpectAnswers: Answers{
+						"useProxy": {Value: "n", Decision: confirm.Denied},
+						"proxy":    {Skipped: true},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		for _, s := range tt.states {
+			t.Run(fmt.Sprintf("%s_%s", tt.name, s.Name), func(t *testing.T) {
+				m := New()
+				m.Questions = tt.questions()
+				tm := teatest.NewTestModel(
+					t, &m,
+					teatest.WithInitialTermSize(120, 40),
+				)
+
+				for _, msg := range s.Inputs {
+					tm.Send(msg)
+					time.Sleep(100 * time.Millisecond)
+				}
+
+				out := stripansi.Bytes(readBts(t, tm.FinalOutput(t, teatest.WithFinalTimeout(2*time.Second))))
+				if s.ExpectView != "" {
+					assert.Contains(t, string(out), s.ExpectView)
+				}
+
+				model := tm.FinalModel(t).(*Model)
+				assert.Equal(t, s.ExpectErr, model.Err())
+				assert.Equal(t, s.ExpectAnswers, model.Answers())
+			})
+		}
+	}
+}
+
+func TestModel_Init(t *testing.T) {
+	tests := []struct {
+		name      string
+		questions []Question
+		wantErr   string
+	}{
+		{
+			name:      "requires a name",
+			questions: []Question{{Prompt: &input.Model{}}},
+			wantErr:   "question at index 0 has no name",
+		},
+		{
+			name:      "requires a prompt",
+			questions: []Question{{Name: "first"}},
+			wantErr:   `question "first" has no prompt`,
+		},
+		{
+			name:      "requires unique names",
+			questions: []Question{{Name: "first", Prompt: &input.Model{}}, {Name: "first", Prompt: &input.Model{}}},
+			wantErr:   `question "first" is defined more than once`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.Questions = tt.questions
+			assert.NotNil(t, m.Init())
+			assert.EqualError(t, m.Err(), tt.wantErr)
+		})
+	}
+}
+
+func readBts(tb testing.TB, r io.Reader) []byte {
+	tb.Helper()
+	bts, err := io.ReadAll(r)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return bts
+}
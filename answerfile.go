@@ -0,0 +1,105 @@
+package answer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jimschubert/answer/confirm"
+	"gopkg.in/yaml.v3"
+)
+
+// recorded converts an answer to the value written to answer files, such that it may be replayed via Values
+func (answer Answer) recorded() any {
+	switch {
+	case answer.Values != nil:
+		return answer.Values
+	case answer.Decision == confirm.Accepted:
+		return true
+	case answer.Decision == confirm.Denied:
+		return false
+	default:
+		return answer.Value
+	}
+}
+
+// Save records the answers to a JSON (.json) or YAML (.yaml, .yml) file, determined by the extension of path.
+// Skipped and Secret answers are not recorded. The file may be replayed via LoadFile or WithReplay.
+func (a Answers) Save(path string) error {
+	return a.save(path, false)
+}
+
+// save records the answers to path, including Secret answers in plaintext only when secrets is true
+func (a Answers) save(path string, secrets bool) error {
+	recorded := make(map[string]any, len(a))
+	for name, answer := range a {
+		if !answer.Skipped && (secrets || !answer.Secret) {
+			recorded[name] = answer.recorded()
+		}
+	}
+
+	var data []byte
+	var err error
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		data, err = json.MarshalIndent(recorded, "", "  ")
+	case ".yaml", ".yml":
+		data, err = yaml.Marshal(recorded)
+	default:
+		return fmt.Errorf("unsupported answer file extension %q", ext)
+	}
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// LoadFile reads values recorded via Answers.Save from a JSON (.json) or YAML (.yaml, .yml) file,
+// determined by the extension of path.
+func LoadFile(path string) (Values, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(Values)
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		err = json.Unmarshal(data, &values)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &values)
+	default:
+		return nil, fmt.Errorf("unsupported answer file extension %q", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid answer file %s: %w", path, err)
+	}
+	return values, nil
+}
+
+// WithRecording returns an AskOpt which saves the answers to path once all questions are answered. See Answers.Save.
+func WithRecording(path string) AskOpt {
+	return func(o *askOpts) {
+		o.recordPath = path
+	}
+}
+
+// WithRecordingSecrets returns an AskOpt which, when enabled, includes the answers to password questions in the file
+// saved via WithRecording. Secrets are written in plaintext, so only enable this where the file is protected.
+func WithRecordingSecrets(enabled bool) AskOpt {
+	return func(o *askOpts) {
+		o.recordSecrets = enabled
+	}
+}
+
+// WithReplay returns an AskOpt which answers all questions non-interactively from a file recorded via WithRecording.
+// Each recorded value is validated as user input would be, so replay fails if, for example, a recorded selection is no
+// longer one of the choices. See LoadFile.
+func WithReplay(path string) AskOpt {
+	return func(o *askOpts) {
+		o.replayPath = path
+		o.nonInteractive = true
+	}
+}
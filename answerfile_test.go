@@ -0,0 +1,257 @@
+package answer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jimschubert/answer/confirm"
+	"github.com/jimschubert/answer/input"
+	"github.com/jimschubert/answer/number"
+	"github.com/jimschubert/answer/password"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnswers_Save(t *testing.T) {
+	answers := Answers{
+		"name":  {Value: "Jim"},
+		"color": {Values: []string{"Red", "Blue"}, Indexes: []int{0, 2}},
+		"pie":   {Value: "n", Decision: confirm.Denied},
+		"proxy": {Skipped: true},
+		"token": {Value: "hunter2", Secret: true},
+	}
+	tests := []struct {
+		name     string
+		file     string
+		expected string
+		wantErr  string
+	}{
+		{
+			name: "saves json",
+			file: "answers.json",
+			expected: `{
+  "color": [
+    "Red",
+    "Blue"
+  ],
+  "name": "Jim",
+  "pie": false
+}`,
+		},
+		{
+			name:     "saves yaml",
+			file:     "answers.yaml",
+			expected: "color:\n    - Red\n    - Blue\nname: Jim\npie: false\n",
+		},
+		{
+			name:    "rejects unknown formats",
+			file:    "answers.txt",
+			wantErr: `unsupported answer file extension ".txt"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			err := answers.Save(path)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			actual, err := os.ReadFile(path)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(actual))
+		})
+	}
+}
+
+func TestAsk_replay(t *testing.T) {
+	tests := []struct {
+		name     string
+		contents string
+		file     string
+		want     Answers
+		wantErr  string
+	}{
+		{
+			name:     "replays json",
+			file:     "answers.json",
+			contents: `{"color": ["Red", "Blue"], "name": "Jim", "pie": false}`,
+			want: Answers{
+				"name":  {Value: "Jim"},
+				"color": {Values: []string{"Red", "Blue"}, Indexes: []int{0, 2}},
+				"pie":   {Value: "n", Decision: confirm.Denied},
+			},
+		},
+		{
+			name:     "replays yaml",
+			file:     "answers.yml",
+			contents: "color:\n  - Green\nname: Jim\npie: true\n",
+			want: Answers{
+				"name":  {Value: "Jim"},
+				"color": {Values: []string{"Green"}, Indexes: []int{1}},
+				"pie":   {Value: "y", Decision: confirm.Accepted},
+			},
+		},
+		{
+			name:     "fails when a recorded selection no longer exists",
+			file:     "answers.json",
+			contents: `{"color": ["Red", "Purple"], "name": "Jim", "pie": false}`,
+			wantErr:  `question "color": invalid choice "Purple"`,
+		},
+		{
+			name:     "fails for invalid files",
+			file:     "answers.json",
+			contents: `{"color": `,
+			wantErr:  "invalid answer file",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			assert.NoError(t, os.WriteFile(path, []byte(tt.contents), 0o600))
+
+			answers, err := Ask(newQuestions(), WithReplay(path))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, answers)
+		})
+	}
+}
+
+func TestAsk_recordThenReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "answers.yaml")
+	recorded, err := Ask(newQuestions(),
+		WithNonInteractive(true),
+		WithSources(Values{"name": "Jim", "color": "Green,Blue", "pie": "n"}),
+		WithRecording(path))
+	assert.NoError(t, err)
+
+	replayed, err := Ask(newQuestions(), WithReplay(path))
+	assert.NoError(t, err)
+	assert.Equal(t, recorded, replayed)
+}
+
+func TestAsk_recordSecrets(t *testing.T) {
+	newSecretQuestions := func() []Question {
+		name := input.New()
+		token := password.New()
+		return []Question{
+			{Name: "name", Prompt: &name},
+			{Name: "token", Prompt: &token},
+		}
+	}
+	want := Answers{
+		"name":  {Value: "Jim"},
+		"token": {Value: "hunter2", Secret: true},
+	}
+	tests := []struct {
+		name          string
+		secrets       bool
+		replaySources []Source
+		wantRecorded  string
+	}{
+		{
+			name:          "leaves secrets out by default",
+			replaySources: []Source{Values{"token": "hunter2"}},
+			wantRecorded:  "name: Jim\n",
+		},
+		{
+			name:         "records secrets when opted in",
+			secrets:      true,
+			wantRecorded: "name: Jim\ntoken: hunter2\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "answers.yaml")
+			recorded, err := Ask(newSecretQuestions(),
+				WithNonInteractive(true),
+				WithSources(Values{"name": "Jim", "token": "hunter2"}),
+				WithRecording(path),
+				WithRecordingSecrets(tt.secrets))
+			assert.NoError(t, err)
+			assert.Equal(t, want, recorded)
+
+			actual, err := os.ReadFile(path)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantRecorded, string(actual))
+
+			replayed, err := Ask(newSecretQuestions(), WithReplay(path), WithSources(tt.replaySources...))
+			assert.NoError(t, err)
+			assert.Equal(t, want, replayed)
+		})
+	}
+}
+
+func TestAnswers_saveLoadResolve(t *testing.T) {
+	newModel := func() *Model {
+		count := number.New()
+		name := input.New()
+		pie := confirm.New()
+		pie.DefaultValue = confirm.Undecided
+		m := New()
+		m.Questions = []Question{
+			{Name: "count", Prompt: &count},
+			{Name: "name", Prompt: &name},
+			{Name: "pie", Prompt: &pie},
+		}
+		return &m
+	}
+	tests := []struct {
+		name    string
+		file    string
+		answers Answers
+	}{
+		{
+			name: "json",
+			file: "answers.json",
+			answers: Answers{
+				"count": {Value: "1000000"},
+				"name":  {Value: "Jim"},
+				"pie":   {Value: "", Decision: confirm.Undecided},
+			},
+		},
+		{
+			name: "yaml",
+			file: "answers.yaml",
+			answers: Answers{
+				"count": {Value: "1000000"},
+				"name":  {Value: "Jim"},
+				"pie":   {Value: "", Decision: confirm.Undecided},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			assert.NoError(t, tt.answers.Save(path))
+			values, err := LoadFile(path)
+			assert.NoError(t, err)
+
+			m := newModel()
+			assert.NoError(t, m.Resolve(values))
+			assert.Equal(t, tt.answers, m.Answers())
+		})
+	}
+
+	t.Run("numbers written by hand", func(t *testing.T) {
+		for file, contents := range map[string]string{
+			"answers.json": `{"count": 1000000, "name": "Jim", "pie": ""}`,
+			"answers.yaml": "count: 1000000\nname: Jim\npie: \"\"\n",
+		} {
+			path := filepath.Join(t.TempDir(), file)
+			assert.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+			values, err := LoadFile(path)
+			assert.NoError(t, err)
+
+			m := newModel()
+			assert.NoError(t, m.Resolve(values), file)
+			assert.Equal(t, "1000000", m.Answers()["count"].Value, file)
+		}
+	})
+}
@@ -0,0 +1,487 @@
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jimschubert/answer/colors"
+	"github.com/jimschubert/answer/outcome"
+)
+
+var (
+	_ tea.Model = (*Model)(nil)
+)
+
+// weekdays are the abbreviated column headers, starting on Sunday
+var weekdays = [...]string{"Su", "Mo", "Tu", "We", "Th", "Fr", "Sa"}
+
+// gridWidth is the width of a week: seven days of two columns, separated by spaces
+const gridWidth = 7*3 - 1
+
+type focus int
+
+const (
+	focusDate focus = iota
+	focusHour
+	focusMinute
+)
+
+// Styles holds relevant styles used for rendering
+// For an introduction to styling with Lip Gloss see:
+// https://github.com/charmbracelet/lipgloss
+type Styles struct {
+	PromptPrefix lipgloss.Style
+	Prompt       lipgloss.Style
+	Header       lipgloss.Style
+	Weekday      lipgloss.Style
+	Day          lipgloss.Style
+	Today        lipgloss.Style
+	Selected     lipgloss.Style
+	// Disabled styles days outside the Min and Max bounds
+	Disabled lipgloss.Style
+	Time     lipgloss.Style
+}
+
+type KeyMap struct {
+	Up          key.Binding
+	Down        key.Binding
+	Left        key.Binding
+	Right       key.Binding
+	PrevMonth   key.Binding
+	NextMonth   key.Binding
+	SwitchFocus key.Binding
+	Enter       key.Binding
+	Quit        key.Binding
+	Help        key.Binding
+}
+
+// ShortHelp returns keybindings to be shown in the mini help view. It's part
+// of the key.Map interface.
+func (k KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.PrevMonth, k.NextMonth, k.SwitchFocus, k.Help, k.Quit}
+}
+
+// FullHelp returns keybindings for the expanded help view. It's part of the
+// key.Map interface.
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.Left, k.Right},
+		{k.PrevMonth, k.NextMonth, k.SwitchFocus},
+		{k.Help, k.Quit},
+	}
+}
+
+var DefaultKeyMap = KeyMap{
+	Up: key.NewBinding(
+		key.WithKeys("k", tea.KeyUp.String()),
+		key.WithHelp("↑/k", "prev week"),
+	),
+	Down: key.NewBinding(
+		key.WithKeys("j", tea.KeyDown.String()),
+		key.WithHelp("↓/j", "next week"),
+	),
+	Left: key.NewBinding(
+		key.WithKeys("h", tea.KeyLeft.String()),
+		key.WithHelp("←/h", "prev day"),
+	),
+	Right: key.NewBinding(
+		key.WithKeys("l", tea.KeyRight.String()),
+		key.WithHelp("→/l", "next day"),
+	),
+	PrevMonth: key.NewBinding(
+		key.WithKeys("<", tea.KeyPgUp.String()),
+		key.WithHelp("</pgup", "prev month"),
+	),
+	NextMonth: key.NewBinding(
+		key.WithKeys(">", tea.KeyPgDown.String()),
+		key.WithHelp(">/pgdown", "next month"),
+	),
+	SwitchFocus: key.NewBinding(
+		key.WithKeys(tea.KeyTab.String()),
+		key.WithHelp("tab", "date/time"),
+	),
+	Enter: key.NewBinding(key.WithKeys(tea.KeyEnter.String())),
+	Quit: key.NewBinding(
+		key.WithKeys("q", tea.KeyEsc.String(), tea.KeyCtrlC.String()),
+		key.WithHelp("q", "quit"),
+	),
+	Help: key.NewBinding(
+		key.WithKeys("?"),
+		key.WithHelp("?", "help"),
+	),
+}
+
+// Model represents the bubble tea model for picking a date, and optionally a time of day, from a calendar
+type Model struct {
+	PromptPrefix string
+	Prompt       string
+	// TimeOfDay allows a time of day to be entered along with the date. Press tab to focus the hour and minute, which
+	// are adjusted via up/down.
+	TimeOfDay bool
+	// MinuteStep is the number of minutes added or subtracted when adjusting the minute
+	MinuteStep int
+	// Min and Max bound the selectable dates (and times, when TimeOfDay is set). A zero time is unbounded.
+	Min time.Time
+	Max time.Time
+	// Layout formats and parses the text of the value, defaulting to "2006-01-02" or "2006-01-02 15:04" with TimeOfDay
+	Layout string
+	// Location is the time zone of the value, defaulting to time.Local
+	Location  *time.Location
+	WeekStart time.Weekday
+	Styles    Styles
+	KeyMap    KeyMap
+	HideHelp  bool
+	value     time.Time
+	focus     focus
+	now       func() time.Time
+	help      help.Model
+	outcome   outcome.Outcome
+	// initialized is true once setup has run, and valued is true once the value has been set
+	initialized bool
+	valued      bool
+}
+
+// New creates a new model with default settings.
+func New() Model {
+	return Model{
+		PromptPrefix: "? ",
+		MinuteStep:   1,
+		KeyMap:       DefaultKeyMap,
+		Styles: Styles{
+			PromptPrefix: lipgloss.NewStyle().Foreground(lipgloss.Color(colors.PromptPrefix)),
+			Header:       lipgloss.NewStyle().Bold(true),
+			Weekday:      lipgloss.NewStyle().Foreground(lipgloss.Color(colors.Placeholder)),
+			Today:        lipgloss.NewStyle().Underline(true),
+			Selected:     lipgloss.NewStyle().Reverse(true).Foreground(lipgloss.Color(colors.PromptPrefix)),
+			Disabled:     lipgloss.NewStyle().Faint(true).Foreground(lipgloss.Color(colors.Placeholder)),
+		},
+		now:  time.Now,
+		help: help.New(),
+	}
+}
+
+func (m *Model) setup() {
+	if m.Prompt == "" {
+		if m.TimeOfDay {
+			m.Prompt = "Please select a date and time:"
+		} else {
+			m.Prompt = "Please select a date:"
+		}
+	}
+	if m.Layout == "" {
+		if m.TimeOfDay {
+			m.Layout = "2006-01-02 15:04"
+		} else {
+			m.Layout = "2006-01-02"
+		}
+	}
+	if m.Location == nil {
+		m.Location = time.Local
+	}
+	if m.MinuteStep < 1 {
+		m.MinuteStep = 1
+	}
+	if m.now == nil {
+		m.now = time.Now
+	}
+	m.KeyMap.SwitchFocus.SetEnabled(m.TimeOfDay)
+
+	if !m.valued {
+		m.value = m.truncate(m.now())
+		m.valued = true
+	}
+	m.value = m.clamp(m.value)
+	m.initialized = true
+}
+
+func (m *Model) Init() tea.Cmd {
+	m.setup()
+	return nil
+}
+
+// truncate converts t to the model's location, dropping seconds, or the time of day when TimeOfDay is not set
+func (m *Model) truncate(t time.Time) time.Time {
+	t = t.In(m.Location)
+	if !m.TimeOfDay {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, m.Location)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, m.Location)
+}
+
+// bounds returns the truncated Min and Max, either of which may be zero
+func (m *Model) bounds() (lower time.Time, upper time.Time) {
+	if !m.Min.IsZero() {
+		lower = m.truncate(m.Min)
+	}
+	if !m.Max.IsZero() {
+		upper = m.truncate(m.Max)
+	}
+	return lower, upper
+}
+
+// clamp keeps t within the Min and Max bounds
+func (m *Model) clamp(t time.Time) time.Time {
+	lower, upper := m.bounds()
+	if !lower.IsZero() && t.Before(lower) {
+		return lower
+	}
+	if !upper.IsZero() && t.After(upper) {
+		return upper
+	}
+	return t
+}
+
+// disabled determines if the entire day falls outside the Min and Max bounds
+func (m *Model) disabled(day time.Time) bool {
+	lower, upper := m.bounds()
+	if !lower.IsZero() && day.AddDate(0, 0, 1).Add(-time.Nanosecond).Before(lower) {
+		return true
+	}
+	return !upper.IsZero() && day.After(upper)
+}
+
+// addMonths moves the value by months, keeping the day within the target month (e.g. Jan 31 + 1 month is Feb 28)
+func (m *Model) addMonths(months int) {
+	v := m.value
+	first := time.Date(v.Year(), v.Month()+time.Month(months), 1, v.Hour(), v.Minute(), 0, 0, m.Location)
+	last := first.AddDate(0, 1, -1).Day()
+	day := v.Day()
+	if day > last {
+		day = last
+	}
+	m.value = m.clamp(first.AddDate(0, 0, day-1))
+}
+
+// adjustTime moves the focused hour or minute by delta, wrapping within the day or hour
+func (m *Model) adjustTime(delta int) {
+	v := m.value
+	hour, minute := v.Hour(), v.Minute()
+	if m.focus == focusHour {
+		hour = ((hour+delta)%24 + 24) % 24
+	} else {
+		minute = ((minute+delta*m.MinuteStep)%60 + 60) % 60
+	}
+	m.value = m.clamp(time.Date(v.Year(), v.Month(), v.Day(), hour, minute, 0, 0, m.Location))
+}
+
+// SetValue sets the value by parsing text with Layout, returning an error if text is invalid or out of bounds
+func (m *Model) SetValue(text string) error {
+	if !m.initialized {
+		m.setup()
+	}
+	t, err := time.ParseInLocation(m.Layout, text, m.Location)
+	if err != nil {
+		return err
+	}
+	return m.SetTime(t)
+}
+
+// SetTime sets the value, returning an error if t is out of bounds
+func (m *Model) SetTime(t time.Time) error {
+	if !m.initialized {
+		m.setup()
+	}
+	t = m.truncate(t)
+	if clamped := m.clamp(t); !clamped.Equal(t) {
+		lower, upper := m.bounds()
+		if t.Before(lower) {
+			return fmt.Errorf("%s is before the minimum allowed %s", t.Format(m.Layout), lower.Format(m.Layout))
+		}
+		return fmt.Errorf("%s is after the maximum allowed %s", t.Format(m.Layout), upper.Format(m.Layout))
+	}
+	m.value = t
+	m.valued = true
+	return nil
+}
+
+// Value returns the selected date (and time) formatted with Layout
+func (m *Model) Value() string {
+	if !m.initialized {
+		m.setup()
+	}
+	return m.value.Format(m.Layout)
+}
+
+// Time returns the selected date (and time)
+func (m *Model) Time() time.Time {
+	if !m.initialized {
+		m.setup()
+	}
+	return m.value
+}
+
+// Reopen allows a submitted model to be edited again, retaining its current value
+func (m *Model) Reopen() {
+	m.outcome = outcome.Pending
+	m.focus = focusDate
+}
+
+// Outcome indicates whether the user has submitted or cancelled the date
+func (m *Model) Outcome() outcome.Outcome {
+	return m.outcome
+}
+
+// Err returns outcome.ErrCancelled if the user cancelled the date
+func (m *Model) Err() error {
+	return m.outcome.Err()
+}
+
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if !m.initialized {
+		m.setup()
+	}
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.help.Width = msg.Width
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.KeyMap.Quit):
+			m.outcome = outcome.Cancelled
+			return m, tea.Quit
+		case key.Matches(msg, m.KeyMap.Enter):
+			m.outcome = outcome.Submitted
+			return m, tea.Quit
+		case key.Matches(msg, m.KeyMap.Help):
+			m.help.ShowAll = !m.help.ShowAll
+		case key.Matches(msg, m.KeyMap.SwitchFocus):
+			m.focus = (m.focus + 1) % (focusMinute + 1)
+		case key.Matches(msg, m.KeyMap.PrevMonth):
+			m.addMonths(-1)
+		case key.Matches(msg, m.KeyMap.NextMonth):
+			m.addMonths(1)
+		case m.focus != focusDate:
+			switch {
+			case key.Matches(msg, m.KeyMap.Up):
+				m.adjustTime(1)
+			case key.Matches(msg, m.KeyMap.Down):
+				m.adjustTime(-1)
+			case key.Matches(msg, m.KeyMap.Left), key.Matches(msg, m.KeyMap.Right):
+				if m.focus == focusHour {
+					m.focus = focusMinute
+				} else {
+					m.focus = focusHour
+				}
+			}
+		case key.Matches(msg, m.KeyMap.Up):
+			m.value = m.clamp(m.value.AddDate(0, 0, -7))
+		case key.Matches(msg, m.KeyMap.Down):
+			m.value = m.clamp(m.value.AddDate(0, 0, 7))
+		case key.Matches(msg, m.KeyMap.Left):
+			m.value = m.clamp(m.value.AddDate(0, 0, -1))
+		case key.Matches(msg, m.KeyMap.Right):
+			m.value = m.clamp(m.value.AddDate(0, 0, 1))
+		}
+	}
+
+	return m, nil
+}
+
+// writeMonth writes the weekday headers and days of the selected month
+func (m *Model) writeMonth(b *strings.Builder) {
+	v := m.value
+	title := v.Format("January 2006")
+	padding := (gridWidth - len(title)) / 2
+	if padding < 0 {
+		padding = 0
+	}
+	b.WriteString(strings.Repeat(" ", padding))
+	b.WriteString(m.Styles.Header.Inline(true).Render(title))
+	b.WriteRune('\n')
+
+	headers := make([]string, 0, len(weekdays))
+	for i := range weekdays {
+		headers = append(headers, weekdays[(int(m.WeekStart)+i)%len(weekdays)])
+	}
+	b.WriteString(m.Styles.Weekday.Inline(true).Render(strings.Join(headers, " ")))
+	b.WriteRune('\n')
+
+	today := m.now().In(m.Location)
+	first := time.Date(v.Year(), v.Month(), 1, 0, 0, 0, 0, m.Location)
+	offset := (int(first.Weekday()) - int(m.WeekStart) + 7) % 7
+	days := first.AddDate(0, 1, -1).Day()
+	b.WriteString(strings.Repeat(" ", offset*3))
+	for day := 1; day <= days; day++ {
+		date := first.AddDate(0, 0, day-1)
+		text := fmt.Sprintf("%2d", day)
+		switch {
+		case day == v.Day() && m.focus == focusDate:
+			b.WriteString(m.Styles.Selected.Inline(true).Render(text))
+		case m.disabled(date):
+			b.WriteString(m.Styles.Disabled.Inline(true).Render(text))
+		case date.Year() == today.Year() && date.YearDay() == today.YearDay():
+			b.WriteString(m.Styles.Today.Inline(true).Render(text))
+		default:
+			b.WriteString(m.Styles.Day.Inline(true).Render(text))
+		}
+
+		if (offset+day)%7 == 0 || day == days {
+			b.WriteRune('\n')
+		} else {
+			b.WriteRune(' ')
+		}
+	}
+}
+
+// writeTime writes the hour and minute, highlighting the focused part
+func (m *Model) writeTime(b *strings.Builder) {
+	hour := fmt.Sprintf("%02d", m.value.Hour())
+	minute := fmt.Sprintf("%02d", m.value.Minute())
+	if m.focus == focusHour {
+		hour = m.Styles.Selected.Inline(true).Render(hour)
+	} else {
+		hour = m.Styles.Time.Inline(true).Render(hour)
+	}
+	if m.focus == focusMinute {
+		minute = m.Styles.Selected.Inline(true).Render(minute)
+	} else {
+		minute = m.Styles.Time.Inline(true).Render(minute)
+	}
+	b.WriteString(m.Styles.Weekday.Inline(true).Render("Time"))
+	b.WriteRune(' ')
+	b.WriteString(hour)
+	b.WriteString(m.Styles.Time.Inline(true).Render(":"))
+	b.WriteString(minute)
+	b.WriteRune('\n')
+}
+
+func (m *Model) View() string {
+	var b strings.Builder
+	if m.PromptPrefix != "" {
+		b.WriteString(m.Styles.PromptPrefix.Inline(true).Render(m.PromptPrefix))
+		if m.Prompt != "" && !strings.HasSuffix(m.PromptPrefix, " ") {
+			b.WriteRune(' ')
+		}
+	}
+
+	if m.outcome == outcome.Cancelled {
+		return b.String()
+	}
+
+	b.WriteString(m.Styles.Prompt.Inline(true).Render(m.Prompt))
+	b.WriteRune(' ')
+	b.WriteString(m.value.Format(m.Layout))
+	b.WriteRune('\n')
+	if m.outcome == outcome.Submitted {
+		// rather than clearing the program output, we want to show the question + answer just as AlecAivazis/survey did
+		return b.String()
+	}
+
+	b.WriteRune('\n')
+	m.writeMonth(&b)
+	if m.TimeOfDay {
+		b.WriteRune('\n')
+		m.writeTime(&b)
+	}
+	if !m.HideHelp {
+		b.WriteRune('\n')
+		b.WriteString(m.help.View(m.KeyMap))
+		b.WriteRune('\n')
+	}
+	return b.String()
+}
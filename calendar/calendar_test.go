@@ -0,0 +1,228 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jimschubert/answer/internal/testutil"
+	"github.com/jimschubert/answer/outcome"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestModel() Model {
+	m := New()
+	m.Location = time.UTC
+	m.HideHelp = true
+	m.now = func() time.Time {
+		return time.Date(2024, time.March, 15, 10, 7, 42, 0, time.UTC)
+	}
+	return m
+}
+
+func TestModel_View(t *testing.T) {
+	enter := testutil.Key(tea.KeyEnter)
+	tests := []struct {
+		name   string
+		setup  func(m *Model)
+		states []testutil.State
+	}{
+		{
+			name: "calendar",
+			states: []testutil.State{
+				{Name: "renders the month of today"},
+				{Name: "renders the next month", Inputs: []tea.Msg{testutil.Key(tea.KeyPgDown)}},
+				{Name: "renders the answer once submitted", Inputs: []tea.Msg{enter}},
+			},
+		},
+		{
+			name: "calendar with help",
+			setup: func(m *Model) {
+				m.HideHelp = false
+			},
+			states: []testutil.State{
+				{Name: "renders help"},
+			},
+		},
+		{
+			name: "calendar starting on monday",
+			setup: func(m *Model) {
+				m.WeekStart = time.Monday
+			},
+			states: []testutil.State{
+				{Name: "renders the next month", Inputs: []tea.Msg{testutil.Key(tea.KeyPgDown)}},
+			},
+		},
+		{
+			name: "calendar with time of day",
+			setup: func(m *Model) {
+				m.TimeOfDay = true
+				m.WeekStart = time.Monday
+			},
+			states: []testutil.State{
+				{Name: "renders the time of day"},
+				{Name: "renders the answer once submitted", Inputs: []tea.Msg{enter}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		for _, s := range tt.states {
+			t.Run(tt.name+"_"+s.Name, func(t *testing.T) {
+				m := newTestModel()
+				if tt.setup != nil {
+					tt.setup(&m)
+				}
+				testutil.RequireGoldenView(t, &m, s)
+			})
+		}
+	}
+}
+
+func TestModel_Update(t *testing.T) {
+	tab := testutil.Key(tea.KeyTab)
+	up := testutil.Key(tea.KeyUp)
+	down := testutil.Key(tea.KeyDown)
+	left := testutil.Key(tea.KeyLeft)
+	right := testutil.Key(tea.KeyRight)
+	prevMonth := testutil.Key(tea.KeyPgUp)
+	nextMonth := testutil.Key(tea.KeyPgDown)
+	tests := []struct {
+		name        string
+		setup       func(m *Model)
+		inputs      []tea.KeyMsg
+		want        string
+		wantOutcome outcome.Outcome
+		wantErr     error
+	}{
+		{
+			name:        "moves by day and week",
+			inputs:      []tea.KeyMsg{right, right, down, left, up, up},
+			want:        "2024-03-09",
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name:        "moves by month",
+			inputs:      []tea.KeyMsg{nextMonth, nextMonth, prevMonth, testutil.Runes("<")},
+			want:        "2024-03-15",
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name: "keeps the day within a shorter month",
+			setup: func(m *Model) {
+				_ = m.SetValue("2024-01-31")
+			},
+			inputs:      []tea.KeyMsg{nextMonth},
+			want:        "2024-02-29",
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name: "keeps the date within bounds",
+			setup: func(m *Model) {
+				m.Min = time.Date(2024, time.March, 10, 0, 0, 0, 0, time.UTC)
+				m.Max = time.Date(2024, time.March, 20, 0, 0, 0, 0, time.UTC)
+			},
+			inputs:      []tea.KeyMsg{up, right, down, down},
+			want:        "2024-03-20",
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name: "starts within bounds",
+			setup: func(m *Model) {
+				m.Min = time.Date(2024, time.April, 1, 0, 0, 0, 0, time.UTC)
+			},
+			inputs:      []tea.KeyMsg{prevMonth},
+			want:        "2024-04-01",
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name: "adjusts the hour and minute",
+			setup: func(m *Model) {
+				m.TimeOfDay = true
+				m.MinuteStep = 15
+			},
+			inputs:      []tea.KeyMsg{tab, up, up, tab, down, right, down},
+			want:        "2024-03-15 11:52",
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name: "wraps the hour within the day",
+			setup: func(m *Model) {
+				m.TimeOfDay = true
+				_ = m.SetValue("2024-03-15 23:30")
+			},
+			inputs:      []tea.KeyMsg{tab, up, tab, tab, right},
+			want:        "2024-03-16 00:30",
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name: "keeps the time within bounds",
+			setup: func(m *Model) {
+				m.TimeOfDay = true
+				m.Min = time.Date(2024, time.March, 15, 9, 30, 0, 0, time.UTC)
+			},
+			inputs:      []tea.KeyMsg{tab, down, down},
+			want:        "2024-03-15 09:30",
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name: "ignores tab without time of day",
+			setup: func(m *Model) {
+				m.Layout = "Jan 2, 2006"
+			},
+			inputs:      []tea.KeyMsg{tab, up, testutil.Key(tea.KeyEnter)},
+			want:        "Mar 8, 2024",
+			wantOutcome: outcome.Submitted,
+		},
+		{
+			name:        "cancelled via esc",
+			inputs:      []tea.KeyMsg{right, testutil.Key(tea.KeyEsc)},
+			want:        "2024-03-16",
+			wantOutcome: outcome.Cancelled,
+			wantErr:     outcome.ErrCancelled,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModel()
+			if tt.setup != nil {
+				tt.setup(&m)
+			}
+			m.Init()
+			for _, msg := range tt.inputs {
+				m.Update(msg)
+			}
+			assert.Equal(t, tt.want, m.Value())
+			assert.Equal(t, tt.wantOutcome, m.Outcome())
+			assert.ErrorIs(t, m.Err(), tt.wantErr)
+		})
+	}
+}
+
+func TestModel_SetValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Time
+		wantErr string
+	}{
+		{name: "valid", value: "2024-06-01", want: time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "invalid", value: "June 1", wantErr: `parsing time "June 1" as "2006-01-02": cannot parse "June 1" as "2006"`},
+		{name: "before minimum", value: "2023-12-31", wantErr: "2023-12-31 is before the minimum allowed 2024-01-01"},
+		{name: "after maximum", value: "2025-01-01", wantErr: "2025-01-01 is after the maximum allowed 2024-12-31"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModel()
+			m.Min = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+			m.Max = time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC)
+			err := m.SetValue(tt.value)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.Equal(t, "2024-03-15", m.Value(), "value should be unmodified")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, m.Time())
+		})
+	}
+}
@@ -4,6 +4,8 @@ const (
 	Placeholder  = "240"
 	PromptPrefix = "#41784f"
 	ErrorPrefix  = "#fc3e35"
+	// MatchHighlight is applied to the runes of a choice or suggestion matching a search
+	MatchHighlight = "#e5a50a"
 
 	// TextLight is text indicated for light backgrounds
 	TextLight = "235"
@@ -4,6 +4,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/jimschubert/answer/colors"
+	"github.com/jimschubert/answer/outcome"
 )
 
 // Decision is an enumeration of decisions available in the confirmation bubble
@@ -105,11 +106,15 @@ type Model struct {
 	// Styles is the group of available styles
 	Styles Styles
 
+	// KeyMap defines the key bindings. Unset bindings are taken from DefaultVerticalKeyMap or DefaultHorizontalKeyMap,
+	// according to Rendering; InputBox rendering only uses Quit.
+	KeyMap KeyMap
+
 	// ShowHelp determines whether to show help where possible (e.g. HorizontalSelection or VerticalSelection rendering)
 	ShowHelp bool
 	selected Decision
 	renderer rendering
-	done     bool
+	outcome  outcome.Outcome
 }
 
 // New creates a new model with default settings.
@@ -169,6 +174,24 @@ func (m *Model) IsUndecided() bool {
 	return m.selected == Undecided
 }
 
+// Reopen allows a submitted model to be edited again, retaining its current decision
+func (m *Model) Reopen() {
+	m.outcome = outcome.Pending
+	if m.renderer != nil {
+		m.renderer.reopen()
+	}
+}
+
+// Outcome indicates whether the user has submitted or cancelled the confirmation
+func (m *Model) Outcome() outcome.Outcome {
+	return m.outcome
+}
+
+// Err returns outcome.ErrCancelled if the user cancelled the confirmation
+func (m *Model) Err() error {
+	return m.outcome.Err()
+}
+
 // Init satisfies the tea.Model interface
 func (m *Model) Init() tea.Cmd {
 	m.selected = m.DefaultValue
@@ -6,8 +6,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/x/exp/teatest"
+	"github.com/jimschubert/answer/outcome"
 	"github.com/jimschubert/stripansi"
 	"github.com/stretchr/testify/assert"
 )
@@ -234,6 +236,41 @@ func TestModel_View(t *testing.T) {
 	}
 }
 
+func TestModel_Outcome(t *testing.T) {
+	tests := []struct {
+		name      string
+		rendering Rendering
+		keyMap    KeyMap
+		inputs    []tea.KeyMsg
+		want      outcome.Outcome
+		wantErr   error
+	}{
+		{name: "pending", inputs: []tea.KeyMsg{{Runes: []rune{'y'}}}, want: outcome.Pending},
+		{name: "submitted via enter", inputs: []tea.KeyMsg{{Type: tea.KeyEnter}}, want: outcome.Submitted},
+		{name: "submitted via enter in selection", rendering: HorizontalSelection, inputs: []tea.KeyMsg{{Type: tea.KeyEnter}}, want: outcome.Submitted},
+		{name: "cancelled via esc", inputs: []tea.KeyMsg{{Type: tea.KeyEsc}}, want: outcome.Cancelled, wantErr: outcome.ErrCancelled},
+		{name: "cancelled via ctrl+c in selection", rendering: VerticalSelection, inputs: []tea.KeyMsg{{Type: tea.KeyCtrlC}}, want: outcome.Cancelled, wantErr: outcome.ErrCancelled},
+		{name: "cancelled via custom quit", keyMap: KeyMap{Quit: key.NewBinding(key.WithKeys("ctrl+q"))}, inputs: []tea.KeyMsg{{Type: tea.KeyCtrlQ}}, want: outcome.Cancelled, wantErr: outcome.ErrCancelled},
+		{name: "custom quit replaces esc", keyMap: KeyMap{Quit: key.NewBinding(key.WithKeys("ctrl+q"))}, inputs: []tea.KeyMsg{{Type: tea.KeyEsc}}, want: outcome.Pending},
+		{name: "cancelled via custom quit in selection", rendering: HorizontalSelection, keyMap: KeyMap{Quit: key.NewBinding(key.WithKeys("ctrl+q"))}, inputs: []tea.KeyMsg{{Type: tea.KeyCtrlQ}}, want: outcome.Cancelled, wantErr: outcome.ErrCancelled},
+		{name: "submitted via default enter with a custom quit in selection", rendering: HorizontalSelection, keyMap: KeyMap{Quit: key.NewBinding(key.WithKeys("ctrl+q"))}, inputs: []tea.KeyMsg{{Type: tea.KeyEnter}}, want: outcome.Submitted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.Prompt = "Do you like testing?"
+			m.Rendering = tt.rendering
+			m.KeyMap = tt.keyMap
+			m.Init()
+			for _, msg := range tt.inputs {
+				m.Update(msg)
+			}
+			assert.Equal(t, tt.want, m.Outcome())
+			assert.ErrorIs(t, m.Err(), tt.wantErr)
+		})
+	}
+}
+
 func readBts(tb testing.TB, r io.Reader) []byte {
 	tb.Helper()
 	bts, err := io.ReadAll(r)
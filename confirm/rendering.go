@@ -7,10 +7,14 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jimschubert/answer/outcome"
 )
 
 type rendering interface {
 	tea.Model
+
+	// reopen restores any state modified on submission
+	reopen()
 }
 
 // KeyMap defines the key bindings for selectable renderings
@@ -18,6 +22,7 @@ type KeyMap struct {
 	Toggle key.Binding
 	Help   key.Binding
 	Enter  key.Binding
+	Quit   key.Binding
 }
 
 var DefaultVerticalKeyMap = KeyMap{
@@ -30,6 +35,10 @@ var DefaultVerticalKeyMap = KeyMap{
 		key.WithHelp("?", "help"),
 	),
 	Enter: key.NewBinding(key.WithKeys(tea.KeyEnter.String())),
+	Quit: key.NewBinding(
+		key.WithKeys(tea.KeyEsc.String(), tea.KeyCtrlC.String()),
+		key.WithHelp("esc", "quit"),
+	),
 }
 var DefaultHorizontalKeyMap = KeyMap{
 	Toggle: key.NewBinding(
@@ -41,6 +50,25 @@ var DefaultHorizontalKeyMap = KeyMap{
 		key.WithHelp("?", "help"),
 	),
 	Enter: key.NewBinding(key.WithKeys(tea.KeyEnter.String())),
+	Quit: key.NewBinding(
+		key.WithKeys(tea.KeyEsc.String(), tea.KeyCtrlC.String()),
+		key.WithHelp("esc", "quit"),
+	),
+}
+
+// withDefaults returns the key map, with any unset bindings taken from defaults
+func (k KeyMap) withDefaults(defaults KeyMap) KeyMap {
+	for _, b := range []struct{ binding, fallback *key.Binding }{
+		{&k.Toggle, &defaults.Toggle},
+		{&k.Help, &defaults.Help},
+		{&k.Enter, &defaults.Enter},
+		{&k.Quit, &defaults.Quit},
+	} {
+		if len(b.binding.Keys()) == 0 {
+			*b.binding = *b.fallback
+		}
+	}
+	return k
 }
 
 // ShortHelp returns keybindings to be shown in the mini help view. It's part
@@ -70,15 +98,19 @@ type selectionRenderer struct {
 func (s *selectionRenderer) Init() tea.Cmd {
 	s.isVertical = s.m.Rendering == VerticalSelection
 	if s.isVertical {
-		s.KeyMap = DefaultVerticalKeyMap
+		s.KeyMap = s.m.KeyMap.withDefaults(DefaultVerticalKeyMap)
 	} else {
-		s.KeyMap = DefaultHorizontalKeyMap
+		s.KeyMap = s.m.KeyMap.withDefaults(DefaultHorizontalKeyMap)
 	}
 	s.help = help.New()
 	s.hideHelp = !s.m.ShowHelp
 	return nil
 }
 
+func (s *selectionRenderer) reopen() {
+	s.hideHelp = !s.m.ShowHelp
+}
+
 // Update satisfies the tea.Model interface
 func (s *selectionRenderer) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
@@ -90,6 +122,11 @@ func (s *selectionRenderer) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		switch {
 		case key.Matches(msg, s.KeyMap.Enter):
 			s.hideHelp = true
+			s.m.outcome = outcome.Submitted
+			return s, tea.Quit
+		case key.Matches(msg, s.KeyMap.Quit):
+			s.hideHelp = true
+			s.m.outcome = outcome.Cancelled
 			return s, tea.Quit
 		case key.Matches(msg, s.KeyMap.Toggle):
 			switch s.m.selected {
@@ -167,6 +204,7 @@ func (s *selectionRenderer) View() string {
 type inputRenderer struct {
 	m    *Model
 	text textinput.Model
+	quit key.Binding
 }
 
 // Init satisfies the tea.Model interface
@@ -206,9 +244,12 @@ func (i *inputRenderer) Init() tea.Cmd {
 	}
 	input.Focus()
 	i.text = input
+	i.quit = i.m.KeyMap.withDefaults(DefaultVerticalKeyMap).Quit
 	return nil
 }
 
+func (i *inputRenderer) reopen() {}
+
 // Update satisfies the tea.Model interface
 func (i *inputRenderer) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var c tea.Cmd
@@ -217,6 +258,9 @@ func (i *inputRenderer) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch {
+		case key.Matches(msg, i.quit):
+			i.m.outcome = outcome.Cancelled
+			return i, tea.Quit
 		case msg.Type == tea.KeyEnter:
 			switch k := strings.ToLower(i.text.Value()); {
 			case strings.HasPrefix(k, strings.ToLower(i.m.AcceptedDecisionText)):
@@ -224,7 +268,7 @@ func (i *inputRenderer) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			case strings.HasPrefix(k, strings.ToLower(i.m.DeniedDecisionText)):
 				i.m.SetDecision(Denied)
 			}
-			i.m.done = true
+			i.m.outcome = outcome.Submitted
 			return i, tea.Quit
 		}
 	}
@@ -243,7 +287,9 @@ func (i *inputRenderer) View() string {
 		}
 	}
 
-	if i.m.done {
+	if i.m.outcome == outcome.Cancelled {
+		return b.String()
+	} else if i.m.outcome == outcome.Submitted {
 		// rather than clearing the program output, we want to show the question + answer just as AlecAivazis/survey did
 		if i.m.Prompt != "" {
 			promptRender := i.m.Styles.Prompt.Inline(true).Render
@@ -0,0 +1,204 @@
+package answer
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/jimschubert/answer/confirm"
+)
+
+var (
+	answerType   = reflect.TypeOf(Answer{})
+	decisionType = reflect.TypeOf(confirm.Undecided)
+)
+
+// Decode writes the answers into the struct pointed to by target.
+//
+// Fields are matched to answers by an `answer:"name"` tag, or by case-insensitive field name when no tag is present.
+// Fields tagged with `answer:"-"` are ignored, as are skipped answers and answers without a matching field.
+//
+// Supported field types:
+//   - string: the input text, the confirm decision text, or the single selected value of a selection
+//   - []string: the selected values of a selection
+//   - []int: the selected indexes of a selection
+//   - bool: the confirm decision, or input text parsed via strconv.ParseBool
+//   - int, uint and float types: the input text or single selected value, parsed via strconv
+//   - confirm.Decision: the confirm decision
+//   - Answer: the raw answer
+func (a Answers) Decode(target any) error {
+	rv := reflect.ValueOf(target)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("decode target must be a non-nil pointer to a struct, got %T", target)
+	}
+
+	rv = rv.Elem()
+	rt := rv.Type()
+	var errs []error
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, ok := field.Tag.Lookup("answer")
+		if name == "-" {
+			continue
+		}
+		if !ok || name == "" {
+			name = field.Name
+		}
+
+		answer, found := a.lookup(name, !ok)
+		if !found || answer.Skipped {
+			continue
+		}
+
+		if err := decodeField(answer, rv.Field(i)); err != nil {
+			errs = append(errs, fmt.Errorf("answer %q: field %s: %w", name, field.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// lookup finds an answer by name, optionally ignoring case when the name is derived from a field name
+func (a Answers) lookup(name string, ignoreCase bool) (Answer, bool) {
+	if answer, ok := a[name]; ok {
+		return answer, true
+	}
+	if ignoreCase {
+		for k, answer := range a {
+			if strings.EqualFold(k, name) {
+				return answer, true
+			}
+		}
+	}
+	return Answer{}, false
+}
+
+// text returns the single textual value of an answer
+func (answer Answer) text() (string, error) {
+	if answer.Values == nil {
+		return answer.Value, nil
+	}
+	switch len(answer.Values) {
+	case 0:
+		return "", nil
+	case 1:
+		return answer.Values[0], nil
+	default:
+		return "", fmt.Errorf("cannot decode %d selected values into a single value", len(answer.Values))
+	}
+}
+
+func decodeField(answer Answer, field reflect.Value) error {
+	switch field.Type() {
+	case answerType:
+		field.Set(reflect.ValueOf(answer))
+		return nil
+	case decisionType:
+		field.SetInt(int64(answer.Decision))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		text, err := answer.text()
+		if err != nil {
+			return err
+		}
+		field.SetString(text)
+	case reflect.Bool:
+		switch answer.Decision {
+		case confirm.Accepted:
+			field.SetBool(true)
+		case confirm.Denied:
+			field.SetBool(false)
+		case confirm.Undecided:
+			text, err := answer.text()
+			if err != nil {
+				return err
+			}
+			if text == "" {
+				field.SetBool(false)
+				return nil
+			}
+			b, err := strconv.ParseBool(text)
+			if err != nil {
+				return fmt.Errorf("cannot decode %q into bool", text)
+			}
+			field.SetBool(b)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		text, err := answer.text()
+		if err != nil {
+			return err
+		}
+		n, err := strconv.ParseInt(text, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("cannot decode %q into %s", text, field.Type())
+		}
+		field.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		text, err := answer.text()
+		if err != nil {
+			return err
+		}
+		n, err := strconv.ParseUint(text, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("cannot decode %q into %s", text, field.Type())
+		}
+		field.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		text, err := answer.text()
+		if err != nil {
+			return err
+		}
+		n, err := strconv.ParseFloat(text, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("cannot decode %q into %s", text, field.Type())
+		}
+		field.SetFloat(n)
+	case reflect.Slice:
+		return decodeSlice(answer, field)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+	return nil
+}
+
+func decodeSlice(answer Answer, field reflect.Value) error {
+	if answer.Values == nil {
+		return fmt.Errorf("cannot decode a non-selection answer into %s", field.Type())
+	}
+
+	switch field.Type().Elem().Kind() {
+	case reflect.String:
+		values := reflect.MakeSlice(field.Type(), len(answer.Values), len(answer.Values))
+		for i, v := range answer.Values {
+			values.Index(i).SetString(v)
+		}
+		field.Set(values)
+	case reflect.Int:
+		indexes := reflect.MakeSlice(field.Type(), len(answer.Indexes), len(answer.Indexes))
+		for i, v := range answer.Indexes {
+			indexes.Index(i).SetInt(int64(v))
+		}
+		field.Set(indexes)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+	return nil
+}
+
+// AskInto runs each of the questions in order as with Ask, decoding the answers into the struct pointed to by target.
+// See Answers.Decode for supported field types.
+func AskInto(questions []Question, target any, options ...AskOpt) error {
+	answers, err := Ask(questions, options...)
+	if err != nil {
+		return err
+	}
+	return answers.Decode(target)
+}
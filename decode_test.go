@@ -0,0 +1,115 @@
+package answer
+
+import (
+	"testing"
+
+	"github.com/jimschubert/answer/confirm"
+	"github.com/stretchr/testify/assert"
+)
+
+type config struct {
+	Name     string           `answer:"name"`
+	Color    string           `answer:"color"`
+	Colors   []string         `answer:"colors"`
+	Indexes  []int            `answer:"colors"`
+	Pie      bool             `answer:"pie"`
+	Decision confirm.Decision `answer:"pie"`
+	Port     int              `answer:"port"`
+	Ratio    float64          `answer:"ratio"`
+	Raw      Answer           `answer:"name"`
+	Region   string
+	Ignored  string `answer:"-"`
+}
+
+func TestAnswers_Decode(t *testing.T) {
+	tests := []struct {
+		name    string
+		answers Answers
+		target  any
+		want    any
+		wantErr string
+	}{
+		{
+			name: "decodes supported types",
+			answers: Answers{
+				"name":   {Value: "Jim"},
+				"color":  {Values: []string{"Green"}, Indexes: []int{1}},
+				"colors": {Values: []string{"Red", "Blue"}, Indexes: []int{0, 2}},
+				"pie":    {Value: "y", Decision: confirm.Accepted},
+				"port":   {Value: "8080"},
+				"ratio":  {Value: "0.5"},
+				"region": {Value: "us-east-1"},
+				"-":      {Value: "nope"},
+			},
+			target: &config{},
+			want: &config{
+				Name:     "Jim",
+				Color:    "Green",
+				Colors:   []string{"Red", "Blue"},
+				Indexes:  []int{0, 2},
+				Pie:      true,
+				Decision: confirm.Accepted,
+				Port:     8080,
+				Ratio:    0.5,
+				Raw:      Answer{Value: "Jim"},
+				Region:   "us-east-1",
+			},
+		},
+		{
+			name:    "decodes input text into bool",
+			answers: Answers{"pie": {Value: "true"}},
+			target:  &config{},
+			want:    &config{Pie: true},
+		},
+		{
+			name:    "ignores skipped answers",
+			answers: Answers{"name": {Skipped: true}, "port": {Skipped: true}},
+			target:  &config{},
+			want:    &config{},
+		},
+		{
+			name:    "requires a pointer to a struct",
+			answers: Answers{},
+			target:  config{},
+			wantErr: "decode target must be a non-nil pointer to a struct, got answer.config",
+		},
+		{
+			name:    "reports invalid numbers",
+			answers: Answers{"port": {Value: "http"}},
+			target:  &config{},
+			wantErr: `answer "port": field Port: cannot decode "http" into int`,
+		},
+		{
+			name:    "reports multiple selections for a single value",
+			answers: Answers{"color": {Values: []string{"Red", "Blue"}, Indexes: []int{0, 2}}},
+			target:  &config{},
+			wantErr: `answer "color": field Color: cannot decode 2 selected values into a single value`,
+		},
+		{
+			name:    "reports input decoded into a slice",
+			answers: Answers{"colors": {Value: "Red"}},
+			target:  &config{},
+			wantErr: "answer \"colors\": field Colors: cannot decode a non-selection answer into []string\n" +
+				"answer \"colors\": field Indexes: cannot decode a non-selection answer into []int",
+		},
+		{
+			name:    "reports unsupported types",
+			answers: Answers{"name": {Value: "Jim"}},
+			target: &struct {
+				Name map[string]string `answer:"name"`
+			}{},
+			wantErr: `answer "name": field Name: unsupported field type map[string]string`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.answers.Decode(tt.target)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, tt.target)
+		})
+	}
+}
@@ -0,0 +1,329 @@
+package editor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jimschubert/answer/colors"
+	"github.com/jimschubert/answer/internal/render"
+	"github.com/jimschubert/answer/outcome"
+	"github.com/jimschubert/answer/validate"
+)
+
+var (
+	_ tea.Model = (*Model)(nil)
+)
+
+// ValidateFunc determines if the edited text is valid, returning nil if valid or an error if invalid
+type ValidateFunc validate.Func
+
+// editorClosedMsg is sent once the editor process exits
+type editorClosedMsg struct {
+	err error
+}
+
+// Styles holds relevant styles used for rendering
+// For an introduction to styling with Lip Gloss see:
+// https://github.com/charmbracelet/lipgloss
+type Styles struct {
+	PromptPrefix lipgloss.Style
+	Prompt       lipgloss.Style
+	ErrorPrefix  lipgloss.Style
+	Placeholder  lipgloss.Style
+}
+
+type KeyMap struct {
+	Open key.Binding
+	Quit key.Binding
+}
+
+var DefaultKeyMap = KeyMap{
+	Open: key.NewBinding(
+		key.WithKeys(tea.KeyEnter.String()),
+		key.WithHelp("enter", "launch editor"),
+	),
+	Quit: key.NewBinding(
+		key.WithKeys(tea.KeyEsc.String(), tea.KeyCtrlC.String()),
+		key.WithHelp("esc", "quit"),
+	),
+}
+
+// Model represents the bubble tea model for text edited in an external editor
+type Model struct {
+	PromptPrefix string
+	Prompt       string
+	// Editor is the command used when neither $VISUAL nor $EDITOR are set. It may include arguments, e.g. "code --wait",
+	// quoted as in a shell when they contain spaces.
+	Editor string
+	// FileExtension is the extension of the temporary file opened in the editor (e.g. ".md"), allowing the editor to
+	// apply syntax highlighting
+	FileExtension string
+	// Validate runs once the editor exits. When the text is invalid, the editor is reopened so the text may be
+	// corrected; if the text is left unchanged, the error is displayed until the user launches the editor again.
+	Validate    ValidateFunc
+	Styles      Styles
+	KeyMap      KeyMap
+	value       string
+	path        string
+	err         error
+	outcome     outcome.Outcome
+	initialized bool
+}
+
+// New creates a new model with default settings.
+func New() Model {
+	editor := "vi"
+	if runtime.GOOS == "windows" {
+		editor = "notepad"
+	}
+	return Model{
+		PromptPrefix: "? ",
+		Editor:       editor,
+		KeyMap:       DefaultKeyMap,
+		Styles: Styles{
+			PromptPrefix: lipgloss.NewStyle().Foreground(lipgloss.Color(colors.PromptPrefix)),
+			ErrorPrefix:  lipgloss.NewStyle().Foreground(lipgloss.Color(colors.ErrorPrefix)),
+			Placeholder:  lipgloss.NewStyle().Foreground(lipgloss.Color(colors.Placeholder)),
+		},
+	}
+}
+
+func (m *Model) setup() {
+	if m.Validate == nil {
+		m.Validate = ValidateFunc(validate.NewValidation())
+	}
+	if m.Prompt == "" {
+		m.Prompt = "Please enter:"
+	}
+	m.initialized = true
+}
+
+func (m *Model) Init() tea.Cmd {
+	m.setup()
+	return nil
+}
+
+// SetValue sets the text, which is the initial content of the file opened in the editor
+func (m *Model) SetValue(value string) {
+	m.value = value
+}
+
+// Resolve sets the value as though entered by the user, returning the error from Validate, if any. This allows the
+// text to be answered without user interaction.
+func (m *Model) Resolve(value string) error {
+	m.SetValue(value)
+	if m.Validate != nil {
+		return m.Validate(value)
+	}
+	return nil
+}
+
+func (m *Model) Value() string {
+	return m.value
+}
+
+// Reopen allows a submitted model to be edited again, retaining its current value and re-running validation
+func (m *Model) Reopen() {
+	m.outcome = outcome.Pending
+	if m.initialized {
+		m.err = m.Validate(m.value)
+	}
+}
+
+// Outcome indicates whether the user has submitted or cancelled the text
+func (m *Model) Outcome() outcome.Outcome {
+	return m.outcome
+}
+
+// Err returns outcome.ErrCancelled if the user cancelled the text
+func (m *Model) Err() error {
+	return m.outcome.Err()
+}
+
+// command determines the editor from $VISUAL, $EDITOR or the Editor fallback
+func (m *Model) command() ([]string, error) {
+	value := m.Editor
+	for _, name := range []string{"VISUAL", "EDITOR"} {
+		if env := os.Getenv(name); strings.TrimSpace(env) != "" {
+			value = env
+			break
+		}
+	}
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, nil
+	}
+	// an unquoted path containing spaces, e.g. C:\Program Files\...\notepad++.exe
+	if info, err := os.Stat(value); err == nil && !info.IsDir() {
+		return []string{value}, nil
+	}
+	return split(value)
+}
+
+// split separates value into arguments as a shell would, honoring single and double quotes as well as backslash
+// escapes (except on Windows, where a backslash is the path separator)
+func split(value string) ([]string, error) {
+	args := make([]string, 0)
+	var b strings.Builder
+	var quote rune
+	inArg, escaped := false, false
+	for _, r := range value {
+		switch {
+		case escaped:
+			b.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'' && runtime.GOOS != "windows":
+			escaped, inArg = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				b.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inArg = r, true
+		case unicode.IsSpace(r):
+			if inArg {
+				args = append(args, b.String())
+				b.Reset()
+				inArg = false
+			}
+		default:
+			b.WriteRune(r)
+			inArg = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, fmt.Errorf("unterminated quote or escape in editor command %q", value)
+	}
+	if inArg {
+		args = append(args, b.String())
+	}
+	return args, nil
+}
+
+// open writes the current value to a temporary file and opens the file in the editor
+func (m *Model) open() tea.Cmd {
+	args, err := m.command()
+	if err != nil {
+		m.err = err
+		return nil
+	}
+	if len(args) == 0 {
+		m.err = errors.New("no editor available, set $VISUAL or $EDITOR")
+		return nil
+	}
+
+	f, err := os.CreateTemp("", "answer-*"+m.FileExtension)
+	if err != nil {
+		m.err = err
+		return nil
+	}
+	_, err = f.WriteString(m.value)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+		m.err = err
+		return nil
+	}
+
+	m.path = f.Name()
+	c := exec.Command(args[0], append(args[1:], m.path)...)
+	return tea.ExecProcess(c, func(err error) tea.Msg {
+		return editorClosedMsg{err: err}
+	})
+}
+
+// closed reads the edited text back, reopening the editor if the changed text is invalid
+func (m *Model) closed(msg editorClosedMsg) tea.Cmd {
+	path := m.path
+	m.path = ""
+	defer func() {
+		_ = os.Remove(path)
+	}()
+	if msg.err != nil {
+		m.err = fmt.Errorf("unable to run editor: %w", msg.err)
+		return nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		m.err = err
+		return nil
+	}
+	previous := m.value
+	// editors commonly add a trailing newline
+	m.value = strings.TrimRight(string(data), "\r\n")
+
+	if m.err = m.Validate(m.value); m.err != nil {
+		if m.value == previous {
+			return nil
+		}
+		return m.open()
+	}
+	m.outcome = outcome.Submitted
+	return tea.Quit
+}
+
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if !m.initialized {
+		m.setup()
+	}
+
+	switch msg := msg.(type) {
+	case editorClosedMsg:
+		return m, m.closed(msg)
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.KeyMap.Open):
+			if m.path == "" {
+				return m, m.open()
+			}
+		case key.Matches(msg, m.KeyMap.Quit):
+			m.outcome = outcome.Cancelled
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func (m *Model) View() string {
+	var b strings.Builder
+	if m.PromptPrefix != "" {
+		b.WriteString(m.Styles.PromptPrefix.Inline(true).Render(m.PromptPrefix))
+		if m.Prompt != "" && !strings.HasSuffix(m.PromptPrefix, " ") {
+			b.WriteRune(' ')
+		}
+	}
+
+	if m.outcome == outcome.Cancelled {
+		return b.String()
+	}
+
+	if m.Prompt != "" {
+		b.WriteString(m.Styles.Prompt.Inline(true).Render(m.Prompt))
+		b.WriteRune(' ')
+	}
+	if m.outcome == outcome.Submitted {
+		// rather than clearing the program output, we want to show the question + answer just as AlecAivazis/survey did
+		render.WriteSummary(&b, m.value, m.Styles.Placeholder)
+		return b.String()
+	}
+
+	b.WriteString(m.Styles.Placeholder.Inline(true).Render(fmt.Sprintf("[%s to launch editor]", m.KeyMap.Open.Help().Key)))
+	b.WriteRune('\n')
+	if m.err != nil {
+		render.WriteError(&b, m.err, m.Styles.ErrorPrefix, m.Styles.Placeholder)
+	}
+	return b.String()
+}
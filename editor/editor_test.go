@@ -0,0 +1,273 @@
+package editor
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+	"github.com/jimschubert/answer/internal/testutil"
+	"github.com/jimschubert/answer/outcome"
+	"github.com/jimschubert/answer/validate"
+	"github.com/jimschubert/stripansi"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeEditor creates an executable script acting as the editor, which is passed the path of the file to edit.
+// Each invocation of the script appends its arguments to the returned log file. The script's directory contains a
+// space, as is common on Windows (e.g. C:\Program Files).
+func fakeEditor(t *testing.T, script string) (editor string, log string) {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("fake editor requires a POSIX shell")
+	}
+	dir := filepath.Join(t.TempDir(), "fake editor")
+	if err := os.Mkdir(dir, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	editor = filepath.Join(dir, "editor.sh")
+	log = filepath.Join(dir, "editor.log")
+	contents := "#!/bin/sh\necho \"$@\" >> '" + log + "'\n" + script + "\n"
+	if err := os.WriteFile(editor, []byte(contents), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	return editor, log
+}
+
+func invocations(t *testing.T, log string) []string {
+	t.Helper()
+	data, err := os.ReadFile(log)
+	if err != nil {
+		return nil
+	}
+	return strings.Split(strings.TrimSpace(string(data)), "\n")
+}
+
+func TestModel_View(t *testing.T) {
+	enter := testutil.Key(tea.KeyEnter)
+	tests := []struct {
+		name   string
+		script string
+		setup  func(m *Model)
+		states []testutil.State
+	}{
+		{
+			name:   "editor",
+			script: `printf 'Fix parser\n\nDetails\n' > "$1"`,
+			states: []testutil.State{
+				{Name: "displays launch hint"},
+				{Name: "summarizes the edited text", Inputs: []tea.Msg{enter}, WaitFor: "(+2 lines)"},
+				{Name: "clears on cancel", Inputs: []tea.Msg{testutil.Key(tea.KeyEsc)}},
+			},
+		},
+		{
+			name: "failing editor",
+			states: []testutil.State{
+				{Name: "displays failure", Inputs: []tea.Msg{editorClosedMsg{err: errors.New("exit status 1")}}},
+			},
+		},
+		{
+			name:   "validatable editor",
+			script: `printf 'Fix' > "$1"`,
+			setup: func(m *Model) {
+				m.Validate = ValidateFunc(validate.NewValidation().MinLength(5, "min: 5 characters"))
+			},
+			states: []testutil.State{
+				{Name: "displays validation message", Inputs: []tea.Msg{enter}, WaitFor: "min: 5 characters"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		for _, s := range tt.states {
+			t.Run(tt.name+"_"+s.Name, func(t *testing.T) {
+				editor, _ := fakeEditor(t, tt.script)
+				t.Setenv("VISUAL", editor)
+
+				m := New()
+				m.Prompt = "Describe the change:"
+				if tt.setup != nil {
+					tt.setup(&m)
+				}
+				testutil.RequireGoldenView(t, &m, s)
+			})
+		}
+	}
+}
+
+func TestModel_Update(t *testing.T) {
+	enter := testutil.Key(tea.KeyEnter)
+	tests := []struct {
+		name            string
+		script          string
+		env             func(editor string) map[string]string
+		setup           func(m *Model, editor string)
+		want            string
+		wantOutcome     outcome.Outcome
+		wantInvocations int
+		wantArgs        string
+	}{
+		{
+			name:   "reads the edited text from VISUAL",
+			script: `printf 'Fix parser\n\nDetails\n' > "$1"`,
+			env: func(editor string) map[string]string {
+				return map[string]string{"VISUAL": editor, "EDITOR": "false"}
+			},
+			want:            "Fix parser\n\nDetails",
+			wantOutcome:     outcome.Submitted,
+			wantInvocations: 1,
+		},
+		{
+			name:   "passes arguments from EDITOR",
+			script: `printf 'Fix parser' > "$2"`,
+			env: func(editor string) map[string]string {
+				return map[string]string{"VISUAL": "", "EDITOR": "'" + editor + "' --wait"}
+			},
+			want:            "Fix parser",
+			wantOutcome:     outcome.Submitted,
+			wantInvocations: 1,
+			wantArgs:        "--wait ",
+		},
+		{
+			name:   "passes quoted arguments",
+			script: `printf '%s' "$1" > "$3"`,
+			env: func(editor string) map[string]string {
+				return map[string]string{"VISUAL": "\"" + editor + "\" 'Fix parser' --wait"}
+			},
+			want:            "Fix parser",
+			wantOutcome:     outcome.Submitted,
+			wantInvocations: 1,
+			wantArgs:        "Fix parser --wait ",
+		},
+		{
+			name:   "falls back to Editor with the file extension",
+			script: `printf 'Fix parser' > "$1"`,
+			env: func(editor string) map[string]string {
+				return map[string]string{"VISUAL": "", "EDITOR": ""}
+			},
+			setup: func(m *Model, editor string) {
+				m.Editor = editor
+				m.FileExtension = ".md"
+			},
+			want:            "Fix parser",
+			wantOutcome:     outcome.Submitted,
+			wantInvocations: 1,
+			wantArgs:        ".md",
+		},
+		{
+			name:   "opens the initial value",
+			script: `printf ' and tests' >> "$1"`,
+			env: func(editor string) map[string]string {
+				return map[string]string{"VISUAL": editor}
+			},
+			setup: func(m *Model, editor string) {
+				m.SetValue("Fix parser")
+			},
+			want:            "Fix parser and tests",
+			wantOutcome:     outcome.Submitted,
+			wantInvocations: 1,
+		},
+		{
+			name:   "reopens the editor when the text is invalid",
+			script: `if [ "$(wc -l < "$(dirname "$0")/editor.log")" -lt 2 ]; then printf 'Fix' > "$1"; else printf 'Fix parser' > "$1"; fi`,
+			env: func(editor string) map[string]string {
+				return map[string]string{"VISUAL": editor}
+			},
+			setup: func(m *Model, editor string) {
+				m.Validate = ValidateFunc(validate.NewValidation().MinLength(5, "min: 5 characters"))
+			},
+			want:            "Fix parser",
+			wantOutcome:     outcome.Submitted,
+			wantInvocations: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			editor, log := fakeEditor(t, tt.script)
+			for k, v := range tt.env(editor) {
+				t.Setenv(k, v)
+			}
+
+			m := New()
+			m.Prompt = "Describe the change:"
+			if tt.setup != nil {
+				tt.setup(&m, editor)
+			}
+			tm := teatest.NewTestModel(t, &m, teatest.WithInitialTermSize(120, 40))
+			tm.Send(enter)
+			tm.WaitFinished(t, teatest.WithFinalTimeout(5*time.Second))
+
+			assert.Equal(t, tt.want, m.Value())
+			assert.Equal(t, tt.wantOutcome, m.Outcome())
+			calls := invocations(t, log)
+			assert.Len(t, calls, tt.wantInvocations)
+			if tt.wantArgs != "" {
+				assert.Contains(t, calls[0], tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestModel_invalidUnchanged(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "answer-*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = f.Close()
+
+	m := New()
+	m.Prompt = "Describe the change:"
+	m.Validate = ValidateFunc(validate.NewValidation().MinLength(5, "min: 5 characters"))
+	m.Init()
+	m.path = f.Name()
+
+	_, cmd := m.Update(editorClosedMsg{})
+	assert.Nil(t, cmd, "editor should not reopen when the text is unchanged")
+	assert.Equal(t, outcome.Pending, m.Outcome())
+	assert.Equal(t, "? Describe the change: [enter to launch editor]\n✘ min: 5 characters\n", stripansi.String(m.View()))
+	assert.NoFileExists(t, f.Name())
+
+	// the user may correct the text by launching the editor again, or give up via esc
+	_, cmd = m.Update(testutil.Key(tea.KeyEnter))
+	assert.NotNil(t, cmd, "editor should launch again via enter")
+	assert.FileExists(t, m.path)
+	_ = os.Remove(m.path)
+
+	m.Update(testutil.Key(tea.KeyEsc))
+	assert.Equal(t, outcome.Cancelled, m.Outcome())
+}
+
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    []string
+		wantErr bool
+	}{
+		{name: "arguments", value: "code --wait", want: []string{"code", "--wait"}},
+		{name: "repeated spaces", value: "  vim   -f ", want: []string{"vim", "-f"}},
+		{name: "double quotes", value: `"/opt/my editor/bin/edit" -w`, want: []string{"/opt/my editor/bin/edit", "-w"}},
+		{name: "single quotes", value: `'/opt/my editor/edit' '-c "set tw=72"'`, want: []string{"/opt/my editor/edit", `-c "set tw=72"`}},
+		{name: "escaped spaces", value: `/opt/my\ editor/edit`, want: []string{"/opt/my editor/edit"}},
+		{name: "empty quotes", value: `edit ""`, want: []string{"edit", ""}},
+		{name: "unterminated quote", value: `"/opt/my editor/edit`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if runtime.GOOS == "windows" {
+				t.Skip("backslash escapes are not supported on Windows")
+			}
+			got, err := split(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
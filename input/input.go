@@ -1,13 +1,20 @@
 package input
 
 import (
+	"context"
+	"fmt"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/jimschubert/answer/colors"
+	"github.com/jimschubert/answer/internal/render"
+	"github.com/jimschubert/answer/outcome"
+	"github.com/jimschubert/answer/suggest"
 	"github.com/jimschubert/answer/validate"
 )
 
@@ -15,24 +22,33 @@ var (
 	_ tea.Model = (*Model)(nil)
 )
 
-type suggestions []string
-
-type keyMap struct {
-	Enter key.Binding
-	Quit  key.Binding
+// suggestions holds the suggestions answering query, or the error encountered while retrieving them
+type suggestions struct {
+	query  string
+	values []string
+	err    error
 }
 
-type exitType int8
+// validated holds the result of ValidateAsync for query
+type validated struct {
+	query string
+	err   error
+}
 
-const (
-	none exitType = iota
-	userQuit
-	userEnter
-)
+type keyMap struct {
+	Enter  key.Binding
+	Quit   key.Binding
+	Next   key.Binding
+	Prev   key.Binding
+	Accept key.Binding
+}
 
 // ValidateFunc determines if the input string is valid, returning nil if valid or an error if invalid
 type ValidateFunc validate.Func
 
+// AsyncValidateFunc determines if the input string is valid like ValidateFunc, for validations which may be slow
+type AsyncValidateFunc validate.AsyncFunc
+
 // Styles holds relevant styles used for rendering
 // For an introduction to styling with Lip Gloss see:
 // https://github.com/charmbracelet/lipgloss
@@ -43,26 +59,69 @@ type Styles struct {
 	Text         lipgloss.Style
 	Placeholder  lipgloss.Style
 	Suggestions  lipgloss.Style
+	// MatchHighlight styles the runes of each suggestion matching the input
+	MatchHighlight lipgloss.Style
+	// SelectedSuggestion styles the suggestion highlighted via keyboard navigation
+	SelectedSuggestion lipgloss.Style
+	// Ghost styles the remainder of the suggestion completing the input, displayed inline after the input
+	Ghost lipgloss.Style
 }
 
 // Model represents the bubble tea model for the input
 type Model struct {
-	PromptPrefix     string
-	Prompt           string
-	Placeholder      string
-	CharLimit        int
-	MaxWidth         int
-	EchoMode         textinput.EchoMode
-	Validate         ValidateFunc
-	Styles           Styles
-	Suggest          func(input string) []string
+	PromptPrefix string
+	Prompt       string
+	Placeholder  string
+	// Default is submitted when the input is empty, and is displayed after the prompt as (default)
+	Default   string
+	CharLimit int
+	MaxWidth  int
+	EchoMode  textinput.EchoMode
+	Validate  ValidateFunc
+	// ValidateAsync runs off the update loop once Validate passes, e.g. to check availability with a remote service.
+	// The context is cancelled once the input changes, and the input can't be submitted until validation completes.
+	// See validate.Func's Async to compose with a validation chain.
+	ValidateAsync AsyncValidateFunc
+	// ValidateDebounce delays ValidateAsync until the input is unchanged for the interval
+	ValidateDebounce time.Duration
+	// ValidatePending is displayed alongside a spinner while ValidateAsync is running
+	ValidatePending string
+	Styles          Styles
+	Suggest         func(input string) []string
+	// SuggestMatch locates the runes of a suggestion matching the input, which are styled via Styles.MatchHighlight
+	// (e.g. suggest.FuzzyMatch alongside suggest.Fuzzy). When nil, suggestions aren't highlighted.
+	SuggestMatch func(input, suggestion string) (suggest.Match, bool)
+	// SuggestContext retrieves suggestions asynchronously, taking precedence over Suggest. The context is cancelled
+	// once the input changes, and a loading indicator is displayed until the suggestions are retrieved.
+	SuggestContext func(ctx context.Context, input string) ([]string, error)
+	// SuggestDebounce delays SuggestContext until the input is unchanged for the interval
+	SuggestDebounce time.Duration
+	// SuggestLoading is displayed alongside a spinner while SuggestContext retrieves suggestions
+	SuggestLoading   string
 	SuggestionPrefix string
-	err              error
-	done             exitType
-	input            textinput.Model
-	initialized      bool
-	suggestions      []string
-	keyMap           keyMap
+	// MaxSuggestions limits the number of suggestions displayed at once, scrolling as suggestions are navigated.
+	// All suggestions are displayed when zero.
+	MaxSuggestions int
+	// SuggestOnError retains suggestions while the input is invalid, e.g. while typing a partial path which only becomes
+	// valid once complete
+	SuggestOnError bool
+	// HideGhost disables the inline completion of the input by the highlighted (or first) suggestion
+	HideGhost   bool
+	err         error
+	outcome     outcome.Outcome
+	input       textinput.Model
+	initialized bool
+	suggestions []string
+	suggestErr  error
+	loading     bool
+	cancel      context.CancelFunc
+	validating  bool
+	submitting  bool
+	stopCheck   context.CancelFunc
+	spinner     spinner.Model
+	cursor      int
+	offset      int
+	keyMap      keyMap
 }
 
 // New creates a new model with default settings.
@@ -70,18 +129,36 @@ func New() Model {
 	return Model{
 		PromptPrefix:     "? ",
 		SuggestionPrefix: "Suggestions:",
+		SuggestLoading:   "Loading suggestions…",
+		ValidatePending:  "Validating…",
 		CharLimit:        0,
 		Styles: Styles{
 			PromptPrefix: lipgloss.NewStyle().Foreground(lipgloss.Color(colors.PromptPrefix)),
 			ErrorPrefix:  lipgloss.NewStyle().Foreground(lipgloss.Color(colors.ErrorPrefix)),
 			Placeholder:  lipgloss.NewStyle().Foreground(lipgloss.Color(colors.Placeholder)),
 			Suggestions:  lipgloss.NewStyle().Italic(true).Foreground(lipgloss.Color(colors.Placeholder)),
+			MatchHighlight: lipgloss.NewStyle().Italic(true).Bold(true).
+				Foreground(lipgloss.Color(colors.MatchHighlight)),
+			SelectedSuggestion: lipgloss.NewStyle().Italic(true).Bold(true).
+				Foreground(lipgloss.Color(colors.PromptPrefix)),
+			Ghost: lipgloss.NewStyle().Foreground(lipgloss.Color(colors.Placeholder)),
 		},
+		cursor:  -1,
+		spinner: spinner.New(spinner.WithSpinner(spinner.MiniDot)),
 		keyMap: keyMap{
 			Quit: key.NewBinding(
 				key.WithKeys(tea.KeyEsc.String(), tea.KeyCtrlC.String()),
 			),
 			Enter: key.NewBinding(key.WithKeys(tea.KeyEnter.String())),
+			Next: key.NewBinding(
+				key.WithKeys(tea.KeyTab.String(), tea.KeyDown.String()),
+			),
+			Prev: key.NewBinding(
+				key.WithKeys(tea.KeyShiftTab.String(), tea.KeyUp.String()),
+			),
+			Accept: key.NewBinding(
+				key.WithKeys(tea.KeyRight.String(), tea.KeyEnd.String()),
+			),
 		},
 	}
 }
@@ -96,10 +173,15 @@ func (m *Model) setup() {
 	input := textinput.New()
 	input.CharLimit = m.CharLimit
 	input.Width = m.MaxWidth
-	if !strings.HasSuffix(m.Prompt, " ") {
-		input.Prompt = m.Prompt + " "
-	} else {
-		input.Prompt = m.Prompt
+	input.Prompt = m.Prompt
+	if m.Default != "" {
+		if input.Prompt != "" {
+			input.Prompt = strings.TrimSuffix(input.Prompt, " ") + " "
+		}
+		input.Prompt += "(" + m.Default + ")"
+	}
+	if !strings.HasSuffix(input.Prompt, " ") {
+		input.Prompt += " "
 	}
 	input.Placeholder = m.Placeholder
 	input.PromptStyle = m.Styles.Prompt
@@ -120,10 +202,47 @@ func (m *Model) SetValue(value string) {
 	m.input.SetValue(value)
 }
 
+// Resolve sets the value as though entered by the user, returning the error from Validate or ValidateAsync, if any.
+// An empty value resolves to Default. This allows the input to be answered without user interaction.
+func (m *Model) Resolve(value string) error {
+	if value == "" {
+		value = m.Default
+	}
+	m.SetValue(value)
+	if m.Validate != nil {
+		if err := m.Validate(value); err != nil {
+			return err
+		}
+	}
+	if m.ValidateAsync != nil {
+		return m.ValidateAsync(context.Background(), value)
+	}
+	return nil
+}
+
 func (m *Model) Value() string {
 	return m.input.Value()
 }
 
+// Reopen allows a submitted model to be edited again, retaining its current value and re-running validation.
+// ValidateAsync isn't re-run, as the value passed it prior to submission.
+func (m *Model) Reopen() {
+	m.outcome = outcome.Pending
+	if m.initialized {
+		m.err = m.Validate(m.input.Value())
+	}
+}
+
+// Outcome indicates whether the user has submitted or cancelled the input
+func (m *Model) Outcome() outcome.Outcome {
+	return m.outcome
+}
+
+// Err returns outcome.ErrCancelled if the user cancelled the input
+func (m *Model) Err() error {
+	return m.outcome.Err()
+}
+
 func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	if !m.initialized {
 		m.setup()
@@ -134,18 +253,77 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		switch {
 		case key.Matches(msg, m.keyMap.Enter):
+			if m.cursor >= 0 && m.cursor < len(m.suggestions) {
+				return m, m.accept(m.suggestions[m.cursor])
+			}
+			if m.input.Value() == "" && m.Default != "" {
+				// the default is validated like typed input, and submitted once valid
+				cmd := m.accept(m.Default)
+				if m.validating {
+					m.submitting = true
+					return m, cmd
+				}
+				if m.err != nil {
+					return m, cmd
+				}
+				return m, tea.Batch(cmd, m.submit())
+			}
+			if m.validating {
+				// submitted once the pending validation passes
+				m.submitting = true
+				return m, nil
+			}
 			if m.err == nil {
-				m.done = userEnter
-				return m, tea.Quit
+				return m, m.submit()
 			}
 		case key.Matches(msg, m.keyMap.Quit):
-			m.done = userQuit
+			m.stopSuggesting()
+			m.stopValidating()
+			m.outcome = outcome.Cancelled
 			return m, tea.Quit
+		case key.Matches(msg, m.keyMap.Next):
+			m.moveCursor(1)
+			return m, nil
+		case key.Matches(msg, m.keyMap.Prev):
+			m.moveCursor(-1)
+			return m, nil
+		case key.Matches(msg, m.keyMap.Accept):
+			if ghost := m.ghost(); ghost != "" {
+				return m, m.accept(m.input.Value() + ghost)
+			}
 		}
 	case error:
 		m.err = msg
 	case suggestions:
-		m.suggestions = msg
+		if msg.query != m.input.Value() {
+			// stale, as the input has changed since the suggestions were requested
+			return m, nil
+		}
+		m.stopSuggesting()
+		m.suggestErr = msg.err
+		m.setSuggestions(msg.values)
+		return m, nil
+	case validated:
+		if !m.validating || msg.query != m.input.Value() {
+			// stale, as the input has changed since validation was requested
+			return m, nil
+		}
+		submitting := m.submitting
+		m.stopValidating()
+		m.err = msg.err
+		if m.err != nil {
+			return m, m.RefreshSuggestions()
+		}
+		if submitting {
+			return m, m.submit()
+		}
+		return m, nil
+	case spinner.TickMsg:
+		if !m.loading && !m.validating {
+			return m, nil
+		}
+		m.spinner, cmd = m.spinner.Update(msg)
+		return m, cmd
 	}
 
 	var cmds []tea.Cmd
@@ -156,18 +334,13 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	changed := before != m.input.Value()
 	if changed {
-		m.err = m.Validate(m.input.Value())
+		cmds = append(cmds, m.validate())
+		m.cursor = -1
+		m.offset = 0
 	}
 
-	if (changed && m.err != nil) || after == "" {
-		m.suggestions = m.suggestions[:0]
-	} else if changed && m.err == nil && m.Suggest != nil {
-		// asynchronously update the suggestions
-		cmds = append(cmds, func() tea.Msg {
-			search := after
-			result := m.Suggest(search)
-			return suggestions(result)
-		})
+	if changed || after == "" {
+		cmds = append(cmds, m.RefreshSuggestions())
 	}
 
 	cmds = append(cmds, cmd)
@@ -175,26 +348,188 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
-func (m *Model) writeError(err error, b *strings.Builder) {
-	if joined, ok := err.(interface{ Unwrap() []error }); ok {
-		// inline removes newlines, so we need to use false when the error is wrapped
-		xRender := m.Styles.ErrorPrefix.Inline(false).Render("✘ ")
-		placeholderRender := m.Styles.Placeholder.Inline(false).Render
-		errs := joined.Unwrap()
-		for _, err := range errs {
-			if _, ok := err.(interface{ Unwrap() []error }); ok { //nolint:govet
-				m.writeError(err, b)
-			} else {
-				b.WriteString(xRender)
-				b.WriteString(placeholderRender(err.Error()))
-				b.WriteRune('\n')
-			}
-		}
+// setSuggestions replaces the suggestions, clearing any highlighted suggestion
+func (m *Model) setSuggestions(values []string) {
+	m.suggestions = values
+	m.cursor = -1
+	m.offset = 0
+}
+
+// moveCursor highlights the next (delta > 0) or previous suggestion, wrapping around and scrolling as necessary
+func (m *Model) moveCursor(delta int) {
+	count := len(m.suggestions)
+	if count == 0 {
+		return
+	}
+	if m.cursor < 0 && delta < 0 {
+		m.cursor = count - 1
 	} else {
-		b.WriteString(m.Styles.ErrorPrefix.Inline(true).Render("✘ "))
-		b.WriteString(m.Styles.Placeholder.Inline(true).Render(err.Error()))
-		b.WriteRune('\n')
+		m.cursor = ((m.cursor+delta)%count + count) % count
+	}
+
+	if m.MaxSuggestions > 0 {
+		if m.cursor < m.offset {
+			m.offset = m.cursor
+		} else if m.cursor >= m.offset+m.MaxSuggestions {
+			m.offset = m.cursor - m.MaxSuggestions + 1
+		}
+	}
+}
+
+// accept replaces the input with value, as if it were typed
+func (m *Model) accept(value string) tea.Cmd {
+	m.input.SetValue(value)
+	m.input.CursorEnd()
+	validation := m.validate()
+	m.cursor = -1
+	m.offset = 0
+	return tea.Batch(validation, m.RefreshSuggestions())
+}
+
+// validate runs Validate against the current value, followed asynchronously by ValidateAsync once Validate passes.
+// Any validation in progress is cancelled.
+func (m *Model) validate() tea.Cmd {
+	m.stopValidating()
+	value := m.input.Value()
+	m.err = m.Validate(value)
+	if m.err != nil || m.ValidateAsync == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.stopCheck = cancel
+	m.validating = true
+	check, debounce := m.ValidateAsync, m.ValidateDebounce
+	return tea.Batch(m.spinner.Tick, func() tea.Msg {
+		if !wait(ctx, debounce) {
+			return nil
+		}
+		err := check(ctx, value)
+		if ctx.Err() != nil {
+			// cancelled, as the input has changed since validation was requested
+			return nil
+		}
+		return validated{query: value, err: err}
+	})
+}
+
+// stopValidating cancels any asynchronous validation in progress, along with a submission awaiting it
+func (m *Model) stopValidating() {
+	if m.stopCheck != nil {
+		m.stopCheck()
+		m.stopCheck = nil
+	}
+	m.validating = false
+	m.submitting = false
+}
+
+// submit completes the input
+func (m *Model) submit() tea.Cmd {
+	m.stopSuggesting()
+	m.outcome = outcome.Submitted
+	return tea.Quit
+}
+
+// wait blocks for the debounce interval, returning false if ctx is cancelled in the meantime
+func wait(ctx context.Context, debounce time.Duration) bool {
+	if debounce <= 0 {
+		return true
+	}
+	timer := time.NewTimer(debounce)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// ghost returns the remainder of the highlighted (or first) suggestion when it completes the input, otherwise empty
+func (m *Model) ghost() string {
+	if m.HideGhost || (m.err != nil && !m.SuggestOnError) || len(m.suggestions) == 0 || m.input.EchoMode != textinput.EchoNormal {
+		return ""
+	}
+	value := m.input.Value()
+	if value == "" || m.input.Position() != len([]rune(value)) {
+		return ""
+	}
+
+	suggestion := m.suggestions[0]
+	if m.cursor >= 0 {
+		suggestion = m.suggestions[m.cursor]
+	}
+	if len(suggestion) <= len(value) || !strings.HasPrefix(suggestion, value) {
+		return ""
+	}
+	if m.input.Width > 0 && len([]rune(suggestion)) >= m.input.Width {
+		return ""
+	}
+	return suggestion[len(value):]
+}
+
+// ghostView renders the input followed by ghost, with the cursor resting on the first rune of ghost
+func (m *Model) ghostView(ghost string) string {
+	runes := []rune(ghost)
+	c := m.input.Cursor
+	c.TextStyle = m.Styles.Ghost
+	c.SetChar(string(runes[0]))
+
+	var b strings.Builder
+	b.WriteString(m.input.PromptStyle.Render(m.input.Prompt))
+	b.WriteString(m.input.TextStyle.Inline(true).Render(m.input.Value()))
+	b.WriteString(c.View())
+	b.WriteString(m.Styles.Ghost.Inline(true).Render(string(runes[1:])))
+	return b.String()
+}
+
+// RefreshSuggestions asynchronously updates the suggestions for the current value, e.g. after Suggest is replaced.
+// Suggestions are cleared while the value is empty or invalid (unless SuggestOnError), and any retrieval in progress
+// is cancelled.
+func (m *Model) RefreshSuggestions() tea.Cmd {
+	m.stopSuggesting()
+	m.suggestErr = nil
+	search := m.input.Value()
+	if (m.err != nil && !m.SuggestOnError) || search == "" || (m.Suggest == nil && m.SuggestContext == nil) {
+		m.setSuggestions(m.suggestions[:0])
+		return nil
+	}
+
+	if m.SuggestContext == nil {
+		complete := m.Suggest
+		return func() tea.Msg {
+			return suggestions{query: search, values: complete(search)}
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.cancel = cancel
+	m.loading = true
+	lookup, debounce := m.SuggestContext, m.SuggestDebounce
+	return tea.Batch(m.spinner.Tick, func() tea.Msg {
+		if !wait(ctx, debounce) {
+			return nil
+		}
+		values, err := lookup(ctx, search)
+		if ctx.Err() != nil {
+			// cancelled, as the input has changed since the suggestions were requested
+			return nil
+		}
+		return suggestions{query: search, values: values, err: err}
+	})
+}
+
+// stopSuggesting cancels any retrieval of suggestions in progress
+func (m *Model) stopSuggesting() {
+	if m.cancel != nil {
+		m.cancel()
+		m.cancel = nil
 	}
+	m.loading = false
+}
+
+func (m *Model) writeError(err error, b *strings.Builder) {
+	render.WriteError(b, err, m.Styles.ErrorPrefix, m.Styles.Placeholder)
 }
 
 func (m *Model) View() string {
@@ -206,9 +541,9 @@ func (m *Model) View() string {
 		}
 	}
 
-	if m.done == userQuit {
+	if m.outcome == outcome.Cancelled {
 		return b.String()
-	} else if m.done == userEnter {
+	} else if m.outcome == outcome.Submitted {
 		// rather than clearing the program output, we want to show the question + answer just as AlecAivazis/survey did
 		if m.Prompt != "" {
 			b.WriteString(m.Styles.Prompt.Inline(true).Render(m.Prompt))
@@ -219,10 +554,47 @@ func (m *Model) View() string {
 		return b.String()
 	}
 
-	b.WriteString(m.input.View())
+	if ghost := m.ghost(); ghost != "" {
+		b.WriteString(m.ghostView(ghost))
+	} else {
+		b.WriteString(m.input.View())
+	}
 	if m.err != nil {
 		b.WriteRune('\n')
 		m.writeError(m.err, &b)
+		if m.SuggestOnError {
+			// the error already ends the line of the input
+			b.WriteString(strings.TrimPrefix(m.suggestionsView(), "\n"))
+		}
+		return b.String()
+	}
+	b.WriteString(m.suggestionsView())
+	return b.String()
+}
+
+// suggestionsView renders the progress of validation and suggestions, or the suggestions themselves, beginning with a
+// newline to end the line of the input
+// match locates the runes of suggestion matching the input for highlighting, only when the provider exposes them
+func (m *Model) match(suggestion string) (suggest.Match, bool) {
+	if m.SuggestMatch == nil {
+		return suggest.Match{}, false
+	}
+	return m.SuggestMatch(m.input.Value(), suggestion)
+}
+
+func (m *Model) suggestionsView() string {
+	var b strings.Builder
+	if m.validating {
+		b.WriteRune('\n')
+		b.WriteString(m.Styles.Suggestions.Render(m.spinner.View() + " " + m.ValidatePending))
+		b.WriteRune('\n')
+	} else if m.loading {
+		b.WriteRune('\n')
+		b.WriteString(m.Styles.Suggestions.Render(m.spinner.View() + " " + m.SuggestLoading))
+		b.WriteRune('\n')
+	} else if m.suggestErr != nil {
+		b.WriteRune('\n')
+		m.writeError(m.suggestErr, &b)
 	} else if len(m.suggestions) > 0 {
 		sRender := m.Styles.Suggestions.Render
 		if m.SuggestionPrefix != "" {
@@ -230,8 +602,28 @@ func (m *Model) View() string {
 			b.WriteString(sRender(m.SuggestionPrefix))
 		}
 		b.WriteRune('\n')
-		for _, suggestion := range m.suggestions {
-			b.WriteString(sRender(suggestion))
+
+		start, end := 0, len(m.suggestions)
+		if m.MaxSuggestions > 0 && end > m.MaxSuggestions {
+			start = m.offset
+			end = start + m.MaxSuggestions
+		}
+		if start > 0 {
+			b.WriteString(sRender(fmt.Sprintf("↑ %d more", start)))
+			b.WriteRune('\n')
+		}
+		for i, suggestion := range m.suggestions[start:end] {
+			if start+i == m.cursor {
+				b.WriteString(m.Styles.SelectedSuggestion.Render(suggestion))
+			} else if match, ok := m.match(suggestion); ok {
+				b.WriteString(render.Highlight(suggestion, match.Positions, m.Styles.Suggestions, m.Styles.MatchHighlight))
+			} else {
+				b.WriteString(sRender(suggestion))
+			}
+			b.WriteRune('\n')
+		}
+		if remaining := len(m.suggestions) - end; remaining > 0 {
+			b.WriteString(sRender(fmt.Sprintf("↓ %d more", remaining)))
 			b.WriteRune('\n')
 		}
 	}
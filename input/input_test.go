@@ -1,17 +1,23 @@
 package input
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
+	"sync/atomic"
 	"testing"
 	"time"
 	"unicode"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/x/exp/teatest"
+	"github.com/jimschubert/answer/outcome"
 	"github.com/jimschubert/answer/suggest"
+	"github.com/jimschubert/answer/validate"
 	"github.com/jimschubert/stripansi"
+	"github.com/muesli/termenv"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -163,6 +169,609 @@ func TestModel_View(t *testing.T) {
 	}
 }
 
+func TestModel_Outcome(t *testing.T) {
+	tests := []struct {
+		name    string
+		inputs  []tea.KeyMsg
+		want    outcome.Outcome
+		wantErr error
+	}{
+		{name: "pending", inputs: []tea.KeyMsg{{Type: tea.KeyRunes, Runes: []rune("Jim")}}, want: outcome.Pending},
+		{name: "submitted via enter", inputs: []tea.KeyMsg{{Type: tea.KeyRunes, Runes: []rune("Jim")}, {Type: tea.KeyEnter}}, want: outcome.Submitted},
+		{name: "cancelled via esc", inputs: []tea.KeyMsg{{Type: tea.KeyRunes, Runes: []rune("Jim")}, {Type: tea.KeyEsc}}, want: outcome.Cancelled, wantErr: outcome.ErrCancelled},
+		{name: "cancelled via ctrl+c", inputs: []tea.KeyMsg{{Type: tea.KeyCtrlC}}, want: outcome.Cancelled, wantErr: outcome.ErrCancelled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.Prompt = "Please enter your name:"
+			m.Init()
+			for _, msg := range tt.inputs {
+				m.Update(msg)
+			}
+			assert.Equal(t, tt.want, m.Outcome())
+			assert.ErrorIs(t, m.Err(), tt.wantErr)
+		})
+	}
+}
+
+func TestModel_Default(t *testing.T) {
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	tests := []struct {
+		name        string
+		setup       func(m *Model)
+		inputs      []tea.KeyMsg
+		wantView    string
+		wantValue   string
+		wantOutcome outcome.Outcome
+	}{
+		{
+			name:        "renders the default after the prompt",
+			wantView:    "? Please enter your name: (Jim)  ",
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name: "renders the default without a prompt",
+			setup: func(m *Model) {
+				m.Prompt = ""
+			},
+			wantView:    "? Please enter: (Jim)  ",
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name:        "submits the default when empty",
+			inputs:      []tea.KeyMsg{enter},
+			wantView:    "? Please enter your name: Jim\n",
+			wantValue:   "Jim",
+			wantOutcome: outcome.Submitted,
+		},
+		{
+			name:        "submits typed input over the default",
+			inputs:      []tea.KeyMsg{{Type: tea.KeyRunes, Runes: []rune("James")}, enter},
+			wantView:    "? Please enter your name: James\n",
+			wantValue:   "James",
+			wantOutcome: outcome.Submitted,
+		},
+		{
+			name: "submits the default once typed input is cleared",
+			setup: func(m *Model) {
+				m.Validate = ValidateFunc(validate.NewValidation().MinLength(2, "min: 2 characters"))
+			},
+			inputs:      []tea.KeyMsg{{Type: tea.KeyRunes, Runes: []rune("J")}, {Type: tea.KeyBackspace}, enter},
+			wantView:    "? Please enter your name: Jim\n",
+			wantValue:   "Jim",
+			wantOutcome: outcome.Submitted,
+		},
+		{
+			name: "validates the default",
+			setup: func(m *Model) {
+				m.Validate = ValidateFunc(validate.NewValidation().MinLength(4, "min: 4 characters"))
+			},
+			inputs:      []tea.KeyMsg{enter},
+			wantView:    "? Please enter your name: (Jim) Jim \n✘ min: 4 characters\n",
+			wantValue:   "Jim",
+			wantOutcome: outcome.Pending,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.Prompt = "Please enter your name:"
+			m.Default = "Jim"
+			if tt.setup != nil {
+				tt.setup(&m)
+			}
+			m.Init()
+			for _, msg := range tt.inputs {
+				m.Update(msg)
+			}
+			if tt.wantView != "" {
+				assert.Equal(t, tt.wantView, stripansi.String(m.View()))
+			}
+			assert.Equal(t, tt.wantValue, m.Value())
+			assert.Equal(t, tt.wantOutcome, m.Outcome())
+		})
+	}
+}
+
+func TestModel_DefaultAsync(t *testing.T) {
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	newModel := func(err error) Model {
+		m := New()
+		m.Prompt = "Please enter your name:"
+		m.Default = "Jim"
+		m.ValidateAsync = func(ctx context.Context, input string) error {
+			return err
+		}
+		m.Init()
+		return m
+	}
+	// validation unwraps the asynchronous validation batched with the refresh of suggestions
+	validation := func(t *testing.T, cmd tea.Cmd) tea.Cmd {
+		t.Helper()
+		batch, ok := cmd().(tea.BatchMsg)
+		if !ok || len(batch) != 1 {
+			t.Fatalf("expected validation, got %v", batch)
+		}
+		return lookup(t, batch[0])
+	}
+
+	t.Run("submits the default once validated", func(t *testing.T) {
+		m := newModel(nil)
+		_, cmd := m.Update(enter)
+		assert.Equal(t, "Jim", m.Value())
+		assert.Equal(t, outcome.Pending, m.Outcome())
+
+		_, cmd = m.Update(validation(t, cmd)())
+		assert.Equal(t, outcome.Submitted, m.Outcome())
+		assert.Equal(t, tea.Quit(), cmd())
+	})
+
+	t.Run("does not submit an invalid default", func(t *testing.T) {
+		m := newModel(errors.New("name is taken"))
+		_, cmd := m.Update(enter)
+		m.Update(validation(t, cmd)())
+		assert.Equal(t, outcome.Pending, m.Outcome())
+		assert.Equal(t, "? Please enter your name: (Jim) Jim \n✘ name is taken\n", stripansi.String(m.View()))
+	})
+
+	t.Run("does not submit once the input changes", func(t *testing.T) {
+		m := newModel(nil)
+		_, cmd := m.Update(enter)
+		check := validation(t, cmd)
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("my")})
+
+		m.Update(check())
+		assert.Equal(t, outcome.Pending, m.Outcome())
+		assert.Equal(t, "Jimmy", m.Value())
+	})
+}
+
+func TestModel_MatchHighlight(t *testing.T) {
+	renderer := lipgloss.NewRenderer(io.Discard)
+	renderer.SetColorProfile(termenv.ANSI)
+
+	tests := []struct {
+		name  string
+		match func(input, suggestion string) (suggest.Match, bool)
+		want  string
+	}{
+		{
+			name:  "highlights matched runes",
+			match: suggest.FuzzyMatch,
+			want:  "\x1b[1me\x1b[0mu-\x1b[1mc\x1b[0mentral-1",
+		},
+		{
+			name: "does not highlight without a matcher",
+			want: "\neu-central-1\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.Styles.MatchHighlight = renderer.NewStyle().Bold(true)
+			m.Styles.Suggestions = renderer.NewStyle()
+			m.SuggestMatch = tt.match
+			m.Init()
+			m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("ec")})
+			m.Update(suggestions{query: "ec", values: []string{"eu-central-1", "other"}})
+
+			view := m.View()
+			assert.Contains(t, view, tt.want)
+			assert.Contains(t, view, "other")
+		})
+	}
+}
+
+func TestModel_SuggestionNavigation(t *testing.T) {
+	tab := tea.KeyMsg{Type: tea.KeyTab}
+	shiftTab := tea.KeyMsg{Type: tea.KeyShiftTab}
+	down := tea.KeyMsg{Type: tea.KeyDown}
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	tests := []struct {
+		name        string
+		setup       func(m *Model)
+		suggestions []string
+		inputs      []tea.KeyMsg
+		want        string
+		wantOutcome outcome.Outcome
+		wantView    string
+	}{
+		{
+			name:        "completes the input with ghost text",
+			suggestions: []string{"James", "Jameson"},
+			want:        "Ja",
+			wantOutcome: outcome.Pending,
+			wantView:    "? Please enter your name: James\nSuggestions:\nJames\nJameson\n",
+		},
+		{
+			name: "hides ghost text",
+			setup: func(m *Model) {
+				m.HideGhost = true
+			},
+			suggestions: []string{"James", "Jameson"},
+			want:        "Ja",
+			wantOutcome: outcome.Pending,
+			wantView:    "? Please enter your name: Ja \nSuggestions:\nJames\nJameson\n",
+		},
+		{
+			name:        "accepts ghost text",
+			suggestions: []string{"James", "Jameson"},
+			inputs:      []tea.KeyMsg{{Type: tea.KeyRight}},
+			want:        "James",
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name:        "ghost text follows the highlighted suggestion",
+			suggestions: []string{"James", "Jameson"},
+			inputs:      []tea.KeyMsg{tab, tab},
+			want:        "Ja",
+			wantOutcome: outcome.Pending,
+			wantView:    "? Please enter your name: Jameson\nSuggestions:\nJames\nJameson\n",
+		},
+		{
+			name:        "accepts the highlighted suggestion rather than submitting",
+			suggestions: []string{"Jim", "Jameson", "Jan"},
+			inputs:      []tea.KeyMsg{tab, tab, enter},
+			want:        "Jameson",
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name:        "wraps to the last suggestion",
+			suggestions: []string{"Jim", "Jameson", "Jan"},
+			inputs:      []tea.KeyMsg{shiftTab, enter},
+			want:        "Jan",
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name:        "wraps to the first suggestion",
+			suggestions: []string{"Jim", "Jameson", "Jan"},
+			inputs:      []tea.KeyMsg{down, down, down, down, enter},
+			want:        "Jim",
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name:        "submits without a highlighted suggestion",
+			suggestions: []string{"Jim", "Jameson", "Jan"},
+			inputs:      []tea.KeyMsg{enter},
+			want:        "Ja",
+			wantOutcome: outcome.Submitted,
+		},
+		{
+			name: "limits the visible suggestions",
+			setup: func(m *Model) {
+				m.MaxSuggestions = 2
+				m.HideGhost = true
+			},
+			suggestions: []string{"Jim", "Jameson", "Jan", "Janet"},
+			want:        "Ja",
+			wantOutcome: outcome.Pending,
+			wantView:    "? Please enter your name: Ja \nSuggestions:\nJim\nJameson\n↓ 2 more\n",
+		},
+		{
+			name: "scrolls the visible suggestions",
+			setup: func(m *Model) {
+				m.MaxSuggestions = 2
+				m.HideGhost = true
+			},
+			suggestions: []string{"Jim", "Jameson", "Jan", "Janet"},
+			inputs:      []tea.KeyMsg{down, down, down},
+			want:        "Ja",
+			wantOutcome: outcome.Pending,
+			wantView:    "? Please enter your name: Ja \nSuggestions:\n↑ 1 more\nJameson\nJan\n↓ 1 more\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.Prompt = "Please enter your name:"
+			if tt.setup != nil {
+				tt.setup(&m)
+			}
+			m.Init()
+			m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Ja")})
+			m.Update(suggestions{query: "Ja", values: tt.suggestions})
+			for _, msg := range tt.inputs {
+				m.Update(msg)
+			}
+
+			assert.Equal(t, tt.want, m.Value())
+			assert.Equal(t, tt.wantOutcome, m.Outcome())
+			if tt.wantView != "" {
+				assert.Equal(t, tt.wantView, stripansi.String(m.View()))
+			}
+		})
+	}
+}
+
+// lookup returns the command retrieving suggestions from the batch returned by RefreshSuggestions for SuggestContext
+func TestModel_SuggestOnError(t *testing.T) {
+	m := New()
+	m.Prompt = "Path:"
+	m.HideGhost = true
+	m.SuggestOnError = true
+	m.Validate = ValidateFunc(validate.NewValidation().MinLength(5, "min: 5 characters"))
+	m.Suggest = func(input string) []string {
+		return []string{"alpha.yaml", "alps/"}
+	}
+	m.Init()
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("al")})
+	m.Update(suggestions{query: "al", values: m.Suggest("al")})
+	assert.NotNil(t, cmd)
+	assert.Equal(t, "? Path: al \n✘ min: 5 characters\nSuggestions:\nalpha.yaml\nalps/\n", stripansi.String(m.View()))
+
+	m.SuggestOnError = false
+	assert.Equal(t, "? Path: al \n✘ min: 5 characters\n", stripansi.String(m.View()))
+}
+
+func lookup(t *testing.T, cmd tea.Cmd) tea.Cmd {
+	t.Helper()
+	batch, ok := cmd().(tea.BatchMsg)
+	if !ok || len(batch) != 2 {
+		t.Fatalf("expected a spinner and lookup, got %v", batch)
+	}
+	return batch[1]
+}
+
+func TestModel_SuggestContext(t *testing.T) {
+	t.Run("renders a loading indicator then the suggestions", func(t *testing.T) {
+		m := New()
+		m.Prompt = "Region:"
+		m.HideGhost = true
+		m.SuggestContext = func(ctx context.Context, input string) ([]string, error) {
+			return []string{"eu-central-1", "eu-west-1"}, nil
+		}
+		m.Init()
+		m.SetValue("eu")
+		cmd := lookup(t, m.RefreshSuggestions())
+
+		assert.Contains(t, stripansi.String(m.View()), "Loading suggestions…")
+		m.Update(cmd())
+		assert.Equal(t, "? Region: eu \nSuggestions:\neu-central-1\neu-west-1\n", stripansi.String(m.View()))
+	})
+
+	t.Run("renders errors", func(t *testing.T) {
+		m := New()
+		m.Prompt = "Region:"
+		m.SuggestContext = func(ctx context.Context, input string) ([]string, error) {
+			return nil, errors.New("service unavailable")
+		}
+		m.Init()
+		m.SetValue("eu")
+		m.Update(lookup(t, m.RefreshSuggestions())())
+		assert.Equal(t, "? Region: eu \n✘ service unavailable\n", stripansi.String(m.View()))
+
+		m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		assert.Equal(t, outcome.Submitted, m.Outcome(), "suggestion errors should not prevent submitting")
+	})
+
+	t.Run("discards stale suggestions", func(t *testing.T) {
+		m := New()
+		m.HideGhost = true
+		m.SuggestContext = func(ctx context.Context, input string) ([]string, error) {
+			return []string{input + "-1"}, nil
+		}
+		m.Init()
+		m.SetValue("eu")
+		stale := lookup(t, m.RefreshSuggestions())()
+		m.SetValue("us")
+		current := lookup(t, m.RefreshSuggestions())()
+
+		m.Update(current)
+		m.Update(stale)
+		assert.Contains(t, stripansi.String(m.View()), "us-1")
+		assert.NotContains(t, stripansi.String(m.View()), "eu-1")
+	})
+
+	t.Run("cancels lookups once the input changes", func(t *testing.T) {
+		started := make(chan struct{})
+		m := New()
+		m.SuggestContext = func(ctx context.Context, input string) ([]string, error) {
+			close(started)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		m.Init()
+		m.SetValue("eu")
+		cmd := lookup(t, m.RefreshSuggestions())
+
+		result := make(chan tea.Msg)
+		go func() {
+			result <- cmd()
+		}()
+		<-started
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("-")})
+
+		select {
+		case msg := <-result:
+			assert.Nil(t, msg)
+		case <-time.After(2 * time.Second):
+			t.Fatal("lookup was not cancelled")
+		}
+	})
+
+	t.Run("debounces lookups", func(t *testing.T) {
+		var calls int32
+		m := New()
+		m.SuggestDebounce = 50 * time.Millisecond
+		m.SuggestContext = func(ctx context.Context, input string) ([]string, error) {
+			atomic.AddInt32(&calls, 1)
+			return []string{input + "-1"}, nil
+		}
+		m.Init()
+		m.SetValue("eu")
+		first := lookup(t, m.RefreshSuggestions())
+		m.SetValue("us")
+		second := lookup(t, m.RefreshSuggestions())
+
+		assert.Nil(t, first(), "superseded lookup should be cancelled while waiting")
+		assert.Equal(t, suggestions{query: "us", values: []string{"us-1"}}, second())
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+}
+
+func TestModel_ValidateAsync(t *testing.T) {
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	taken := func(ctx context.Context, input string) error {
+		if input == "jim" {
+			return errors.New("username is taken")
+		}
+		return nil
+	}
+
+	t.Run("renders a pending indicator and submits once validated", func(t *testing.T) {
+		m := New()
+		m.Prompt = "Username:"
+		m.ValidateAsync = taken
+		m.Init()
+		m.SetValue("james")
+		cmd := lookup(t, m.validate())
+
+		assert.Contains(t, stripansi.String(m.View()), "Validating…")
+		m.Update(enter)
+		assert.Equal(t, outcome.Pending, m.Outcome(), "pending validation should delay submitting")
+
+		_, cmd = m.Update(cmd())
+		assert.Equal(t, "? Username: james\n", stripansi.String(m.View()))
+		assert.Equal(t, outcome.Submitted, m.Outcome())
+		assert.Equal(t, tea.Quit(), cmd())
+	})
+
+	t.Run("does not submit once validation fails", func(t *testing.T) {
+		m := New()
+		m.Prompt = "Username:"
+		m.ValidateAsync = taken
+		m.Init()
+		m.SetValue("jim")
+		cmd := lookup(t, m.validate())
+
+		m.Update(enter)
+		m.Update(cmd())
+		assert.Equal(t, outcome.Pending, m.Outcome())
+		assert.Equal(t, "? Username: jim \n✘ username is taken\n", stripansi.String(m.View()))
+
+		m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+		m.Update(lookup(t, m.validate())())
+		assert.Equal(t, outcome.Pending, m.Outcome(), "a failed submission should not be queued for later input")
+	})
+
+	t.Run("renders errors", func(t *testing.T) {
+		m := New()
+		m.Prompt = "Username:"
+		m.ValidateAsync = taken
+		m.Init()
+		m.SetValue("jim")
+		m.Update(lookup(t, m.validate())())
+		assert.Equal(t, "? Username: jim \n✘ username is taken\n", stripansi.String(m.View()))
+
+		m.Update(enter)
+		assert.Equal(t, outcome.Pending, m.Outcome(), "validation errors should prevent submitting")
+	})
+
+	t.Run("clears the highlighted suggestion on errors", func(t *testing.T) {
+		m := New()
+		m.Suggest = func(input string) []string {
+			return []string{"jim", "jimmy"}
+		}
+		m.ValidateAsync = taken
+		m.Init()
+		m.SetValue("jim")
+		cmd := lookup(t, m.validate())
+		m.Update(suggestions{query: "jim", values: m.Suggest("jim")})
+		m.Update(tea.KeyMsg{Type: tea.KeyDown})
+
+		m.Update(cmd())
+		assert.NotPanics(t, func() {
+			m.Update(enter)
+		})
+		assert.Equal(t, "jim", m.Value())
+		assert.Equal(t, outcome.Pending, m.Outcome())
+	})
+
+	t.Run("runs after synchronous validations pass", func(t *testing.T) {
+		var calls int32
+		m := New()
+		m.Prompt = "Username:"
+		m.Validate = ValidateFunc(validate.NewValidation().MinLength(2, "min: 2 characters"))
+		m.ValidateAsync = func(ctx context.Context, input string) error {
+			atomic.AddInt32(&calls, 1)
+			return nil
+		}
+		m.Init()
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+		assert.Equal(t, "? Username: j \n✘ min: 2 characters\n", stripansi.String(m.View()))
+		assert.False(t, m.validating)
+
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("i")})
+		assert.True(t, m.validating)
+		assert.Equal(t, int32(0), atomic.LoadInt32(&calls))
+	})
+
+	t.Run("discards stale results", func(t *testing.T) {
+		m := New()
+		m.ValidateAsync = taken
+		m.Init()
+		m.SetValue("jim")
+		stale := lookup(t, m.validate())()
+		m.SetValue("james")
+		current := lookup(t, m.validate())()
+
+		m.Update(stale)
+		assert.True(t, m.validating)
+		m.Update(current)
+		assert.False(t, m.validating)
+		assert.NoError(t, m.err)
+	})
+
+	t.Run("cancels validation once the input changes", func(t *testing.T) {
+		started := make(chan struct{})
+		m := New()
+		m.ValidateAsync = func(ctx context.Context, input string) error {
+			if input == "jim" {
+				close(started)
+				<-ctx.Done()
+			}
+			return ctx.Err()
+		}
+		m.Init()
+		m.SetValue("jim")
+		cmd := lookup(t, m.validate())
+
+		result := make(chan tea.Msg)
+		go func() {
+			result <- cmd()
+		}()
+		<-started
+		m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("-")})
+
+		select {
+		case msg := <-result:
+			assert.Nil(t, msg)
+		case <-time.After(2 * time.Second):
+			t.Fatal("validation was not cancelled")
+		}
+	})
+
+	t.Run("debounces validation", func(t *testing.T) {
+		var calls int32
+		m := New()
+		m.ValidateDebounce = 50 * time.Millisecond
+		m.ValidateAsync = func(ctx context.Context, input string) error {
+			atomic.AddInt32(&calls, 1)
+			return taken(ctx, input)
+		}
+		m.Init()
+		m.SetValue("james")
+		first := lookup(t, m.validate())
+		m.SetValue("jim")
+		second := lookup(t, m.validate())
+
+		assert.Nil(t, first(), "superseded validation should be cancelled while waiting")
+		assert.Equal(t, validated{query: "jim", err: errors.New("username is taken")}, second())
+		assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	})
+}
+
 func readBts(tb testing.TB, r io.Reader) []byte {
 	tb.Helper()
 	bts, err := io.ReadAll(r)
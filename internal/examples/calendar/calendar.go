@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jimschubert/answer/calendar"
+)
+
+func main() {
+	m := calendar.New()
+	m.Prompt = "Maintenance window:"
+	m.TimeOfDay = true
+	m.MinuteStep = 15
+	m.WeekStart = time.Monday
+	m.Min = time.Now()
+	m.Max = time.Now().AddDate(0, 3, 0)
+	p := tea.NewProgram(&m)
+	if _, err := p.Run(); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := m.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "Maintenance begins %s (in %s)\n", m.Value(), time.Until(m.Time()).Round(time.Minute))
+}
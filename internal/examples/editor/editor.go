@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jimschubert/answer/editor"
+	"github.com/jimschubert/answer/validate"
+)
+
+func main() {
+	m := editor.New()
+	m.Prompt = "Please describe the change:"
+	m.FileExtension = ".md"
+	m.SetValue("# Summary\n")
+	m.Validate = editor.ValidateFunc(validate.NewValidation().
+		MinLength(20, "min: 20 characters"))
+	p := tea.NewProgram(&m)
+	if _, err := p.Run(); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := m.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "Your description:\n%s\n", m.Value())
+}
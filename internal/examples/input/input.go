@@ -1,11 +1,13 @@
 package main
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"os"
+	"time"
 	"unicode"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -14,7 +16,11 @@ import (
 	"github.com/jimschubert/answer/validate"
 )
 
-var complexValidation = flag.Bool("complex", false, "use complex validations")
+var (
+	complexValidation = flag.Bool("complex", false, "use complex validations")
+	asyncValidation   = flag.Bool("async", false, "use a slow asynchronous validation")
+	defaultName       = flag.String("default", "", "name submitted when none is entered")
+)
 
 func main() {
 	flag.Parse()
@@ -22,6 +28,7 @@ func main() {
 	m := input.New()
 	m.Prompt = "Please enter your name:"
 	m.Placeholder = "(first name only)"
+	m.Default = *defaultName
 	m.Suggest = suggest.LevenshteinDistance([]string{"Jim", "James", "Jameson"},
 		suggest.LevenshteinDistanceMin(0),
 		suggest.LevenshteinDistanceMax(4))
@@ -51,6 +58,22 @@ func main() {
 		m.Validate = requireUppercase
 	}
 
+	if *asyncValidation {
+		// simulates a remote check, which is cancelled as the name is edited
+		m.ValidateDebounce = 200 * time.Millisecond
+		m.ValidateAsync = func(ctx context.Context, input string) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(time.Second):
+			}
+			if input == "Jim" {
+				return errors.New("name is taken")
+			}
+			return nil
+		}
+	}
+
 	p := tea.NewProgram(&m)
 	if _, err := p.Run(); err != nil {
 		log.Fatal(err)
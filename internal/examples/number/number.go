@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jimschubert/answer/number"
+)
+
+func main() {
+	port := number.New()
+	port.Prompt = "Port:"
+	port.Placeholder = "8080"
+	port.Min = 1024
+	port.Max = 65535
+	if _, err := tea.NewProgram(&port).Run(); err != nil {
+		log.Fatal(err)
+	}
+	if err := port.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	timeout := number.New()
+	timeout.Prompt = "Timeout (seconds):"
+	timeout.Float = true
+	timeout.Min = 0
+	timeout.Step = 0.5
+	if _, err := tea.NewProgram(&timeout).Run(); err != nil {
+		log.Fatal(err)
+	}
+	if err := timeout.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "Listening on port %d with a timeout of %gs\n", port.Int(), timeout.Float64())
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jimschubert/answer/password"
+	"github.com/jimschubert/answer/validate"
+)
+
+func main() {
+	m := password.New()
+	m.Prompt = "Choose a password:"
+	m.Confirm = true
+	m.Validate = password.ValidateFunc(validate.NewValidation().
+		MinLength(8, "min: 8 characters").
+		Strength(3, "use a mix of upper and lowercase letters, digits and symbols"))
+	p := tea.NewProgram(&m)
+	if _, err := p.Run(); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := m.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "Your password has %d characters\n", len([]rune(m.Value())))
+}
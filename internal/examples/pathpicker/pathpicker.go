@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jimschubert/answer/pathpicker"
+	"github.com/jimschubert/answer/validate"
+)
+
+func main() {
+	m := pathpicker.New()
+	m.Prompt = "Config file:"
+	m.Placeholder = "~/.config/app/config.yaml"
+	m.Extensions = []string{".yaml", ".yml"}
+	m.Validate = pathpicker.ValidateFunc(validate.NewValidation().
+		FileExists("choose an existing file").
+		Extension(m.Extensions, "choose a YAML file"))
+	p := tea.NewProgram(&m)
+	if _, err := p.Run(); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := m.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "Using %s\n", m.Path())
+}
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/jimschubert/answer"
+	"github.com/jimschubert/answer/confirm"
+	"github.com/jimschubert/answer/input"
+	"github.com/jimschubert/answer/selection"
+)
+
+func main() {
+	name := input.New()
+	name.Prompt = "Please enter your name:"
+	name.Placeholder = "(first name only)"
+
+	letters := selection.New()
+	letters.Prompt = "Please select your favorite letters:"
+	letters.PerPage = 6
+	for i := 'A'; i <= 'Z'; i++ {
+		letters.Choices = append(letters.Choices, string(i))
+	}
+
+	pie := confirm.New()
+	pie.Prompt = "Do you like pie?"
+
+	answers, err := answer.Ask([]answer.Question{
+		{Name: "name", Prompt: &name},
+		{Name: "letters", Prompt: &letters},
+		{Name: "pie", Prompt: &pie},
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "Hi, %s! You selected %v and answered %q about pie.\n",
+		answers["name"].Value, answers["letters"].Values, answers["pie"].Decision.YesNoString())
+}
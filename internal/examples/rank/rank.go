@@ -0,0 +1,27 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jimschubert/answer/rank"
+)
+
+func main() {
+	m := rank.New()
+	m.Prompt = "Please rank these features by priority:"
+	m.PerPage = 4
+	m.Choices = []string{"Dark mode", "Export", "Sharing", "Offline support", "Plugins", "Notifications"}
+	p := tea.NewProgram(&m)
+	if _, err := p.Run(); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := m.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "Your priorities: %v\n", m.Values())
+}
@@ -28,5 +28,9 @@ func main() {
 		log.Fatal(err)
 	}
 
+	if err := m.Err(); err != nil {
+		log.Fatal(err)
+	}
+
 	_, _ = fmt.Fprintf(os.Stdout, "You selected: %v\n", m.SelectedValues())
 }
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jimschubert/answer/textarea"
+	"github.com/jimschubert/answer/validate"
+)
+
+func main() {
+	m := textarea.New()
+	m.Prompt = "Please enter a commit message:"
+	m.Placeholder = "Summary, then a blank line and details"
+	m.MaxLines = 20
+	m.Validate = textarea.ValidateFunc(validate.NewValidation().
+		MinLength(10, "min: 10 characters").
+		MaxLength(1000, "max: 1000 characters"))
+	p := tea.NewProgram(&m)
+	if _, err := p.Run(); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := m.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "Your message:\n%s\n", m.Value())
+}
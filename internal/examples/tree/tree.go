@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jimschubert/answer/tree"
+)
+
+func main() {
+	m := tree.New()
+	m.Prompt = "Please select the files to include:"
+	m.PerPage = 8
+
+	wd, err := os.Getwd()
+	if err != nil {
+		log.Fatal(err)
+	}
+	m.Roots = []*tree.Node{{Label: filepath.Base(wd), Value: wd, Lazy: true}}
+	m.LoadChildren = func(node *tree.Node) ([]*tree.Node, error) {
+		dir := node.String()
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		children := make([]*tree.Node, 0, len(entries))
+		for _, entry := range entries {
+			children = append(children, &tree.Node{
+				Label: entry.Name(),
+				Value: filepath.Join(dir, entry.Name()),
+				Lazy:  entry.IsDir(),
+			})
+		}
+		return children, nil
+	}
+
+	p := tea.NewProgram(&m)
+	if _, err := p.Run(); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := m.Err(); err != nil {
+		log.Fatal(err)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "You selected: %v\n", m.SelectedValues())
+}
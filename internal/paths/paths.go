@@ -0,0 +1,37 @@
+package paths
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Separators are the runes which separate elements of a path. Both are accepted on Windows.
+const Separators = "/" + string(filepath.Separator)
+
+// Expand replaces a leading ~ with the user's home directory. The path is returned unmodified if it does not begin
+// with ~ (or ~ followed by a separator), or if the home directory cannot be determined.
+func Expand(path string) string {
+	if path != "~" && !(strings.HasPrefix(path, "~") && strings.ContainsRune(Separators, rune(path[1]))) {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return home + path[1:]
+}
+
+// HasExtension determines if the path has one of the extensions, ignoring case. Extensions may omit the leading dot.
+func HasExtension(path string, extensions ...string) bool {
+	ext := filepath.Ext(path)
+	for _, extension := range extensions {
+		if !strings.HasPrefix(extension, ".") {
+			extension = "." + extension
+		}
+		if strings.EqualFold(ext, extension) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package paths
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpand(t *testing.T) {
+	t.Setenv("HOME", "/home/answer")
+	t.Setenv("USERPROFILE", "/home/answer")
+	tests := []struct {
+		path string
+		want string
+	}{
+		{path: "", want: ""},
+		{path: "~", want: "/home/answer"},
+		{path: "~/.config", want: "/home/answer/.config"},
+		{path: "~other/.config", want: "~other/.config"},
+		{path: "/etc/~", want: "/etc/~"},
+		{path: "relative/path", want: "relative/path"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, Expand(tt.path))
+		})
+	}
+}
+
+func TestHasExtension(t *testing.T) {
+	tests := []struct {
+		path       string
+		extensions []string
+		want       bool
+	}{
+		{path: "config.yaml", extensions: []string{".yaml"}, want: true},
+		{path: "config.YAML", extensions: []string{".yml", ".yaml"}, want: true},
+		{path: "config.yml", extensions: []string{"yml"}, want: true},
+		{path: "config.json", extensions: []string{".yaml"}, want: false},
+		{path: "config", extensions: []string{".yaml"}, want: false},
+		{path: "config.yaml", extensions: nil, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.want, HasExtension(tt.path, tt.extensions...))
+		})
+	}
+}
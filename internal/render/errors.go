@@ -0,0 +1,31 @@
+package render
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// WriteError writes err to b as a line prefixed by a ✘ styled by prefix, with the error message styled by text.
+// Errors created via errors.Join are unwrapped recursively, writing one line per error.
+func WriteError(b *strings.Builder, err error, prefix, text lipgloss.Style) {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		// inline removes newlines, so we need to use false when the error is wrapped
+		xRender := prefix.Inline(false).Render("✘ ")
+		textRender := text.Inline(false).Render
+		errs := joined.Unwrap()
+		for _, err := range errs {
+			if _, ok := err.(interface{ Unwrap() []error }); ok { //nolint:govet
+				WriteError(b, err, prefix, text)
+			} else {
+				b.WriteString(xRender)
+				b.WriteString(textRender(err.Error()))
+				b.WriteRune('\n')
+			}
+		}
+	} else {
+		b.WriteString(prefix.Inline(true).Render("✘ "))
+		b.WriteString(text.Inline(true).Render(err.Error()))
+		b.WriteRune('\n')
+	}
+}
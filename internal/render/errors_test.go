@@ -0,0 +1,41 @@
+package render
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want string
+	}{
+		{
+			name: "single error",
+			err:  errors.New("name must be uppercase"),
+			want: "✘ name must be uppercase\n",
+		},
+		{
+			name: "joined errors",
+			err:  errors.Join(errors.New("min: 2 characters"), errors.New("letters only")),
+			want: "✘ min: 2 characters\n✘ letters only\n",
+		},
+		{
+			name: "nested joined errors",
+			err:  errors.Join(errors.Join(errors.New("min: 2 characters"), nil), errors.New("letters only")),
+			want: "✘ min: 2 characters\n✘ letters only\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			WriteError(&b, tt.err, lipgloss.NewStyle(), lipgloss.NewStyle())
+			assert.Equal(t, tt.want, b.String())
+		})
+	}
+}
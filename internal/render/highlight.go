@@ -0,0 +1,36 @@
+package render
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Highlight renders value with the runes at the given rune positions styled by highlight, and all other runes styled by
+// text. Consecutive runes sharing a style are rendered together.
+func Highlight(value string, positions []int, text, highlight lipgloss.Style) string {
+	if len(positions) == 0 {
+		return text.Render(value)
+	}
+
+	matched := make(map[int]bool, len(positions))
+	for _, p := range positions {
+		matched[p] = true
+	}
+
+	var b strings.Builder
+	var segment strings.Builder
+	runes := []rune(value)
+	for i, r := range runes {
+		segment.WriteRune(r)
+		if i == len(runes)-1 || matched[i] != matched[i+1] {
+			if matched[i] {
+				b.WriteString(highlight.Render(segment.String()))
+			} else {
+				b.WriteString(text.Render(segment.String()))
+			}
+			segment.Reset()
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,51 @@
+package render
+
+import (
+	"io"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHighlight(t *testing.T) {
+	renderer := lipgloss.NewRenderer(io.Discard)
+	renderer.SetColorProfile(termenv.ANSI)
+	highlight := renderer.NewStyle().Bold(true)
+	tests := []struct {
+		name      string
+		value     string
+		positions []int
+		want      string
+	}{
+		{
+			name:  "no positions",
+			value: "eu-west-1",
+			want:  "eu-west-1",
+		},
+		{
+			name:      "consecutive positions",
+			value:     "eu-west-1",
+			positions: []int{0, 1},
+			want:      "\x1b[1meu\x1b[0m-west-1",
+		},
+		{
+			name:      "separated positions",
+			value:     "eu-central-1",
+			positions: []int{0, 3, 11},
+			want:      "\x1b[1me\x1b[0mu-\x1b[1mc\x1b[0mentral-\x1b[1m1\x1b[0m",
+		},
+		{
+			name:      "rune positions",
+			value:     "café au lait",
+			positions: []int{3, 4},
+			want:      "caf\x1b[1mé \x1b[0mau lait",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Highlight(tt.value, tt.positions, lipgloss.NewStyle(), highlight))
+		})
+	}
+}
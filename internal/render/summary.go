@@ -0,0 +1,21 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// WriteSummary writes the first line of a multi-line value to b, followed by the number of additional lines styled by
+// more. The summary is terminated by a newline.
+func WriteSummary(b *strings.Builder, value string, more lipgloss.Style) {
+	lines := strings.Split(value, "\n")
+	b.WriteString(lines[0])
+	if count := len(lines) - 1; count == 1 {
+		b.WriteString(more.Inline(true).Render(" (+1 line)"))
+	} else if count > 1 {
+		b.WriteString(more.Inline(true).Render(fmt.Sprintf(" (+%d lines)", count)))
+	}
+	b.WriteRune('\n')
+}
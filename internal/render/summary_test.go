@@ -0,0 +1,29 @@
+package render
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteSummary(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{name: "empty", value: "", want: "\n"},
+		{name: "single line", value: "Fix parser", want: "Fix parser\n"},
+		{name: "two lines", value: "Fix parser\nDetails", want: "Fix parser (+1 line)\n"},
+		{name: "many lines", value: "Fix parser\n\nDetails", want: "Fix parser (+2 lines)\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			WriteSummary(&b, tt.value, lipgloss.NewStyle())
+			assert.Equal(t, tt.want, b.String())
+		})
+	}
+}
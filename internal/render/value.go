@@ -0,0 +1,19 @@
+package render
+
+import (
+	"fmt"
+)
+
+// Value returns the text form of a choice's value, or label when the value is nil
+func Value(value any, label string) string {
+	switch v := value.(type) {
+	case nil:
+		return label
+	case string:
+		return v
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,26 @@
+package render
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		want  string
+	}{
+		{name: "nil uses the label", value: nil, want: "Label"},
+		{name: "string", value: "us-east-1", want: "us-east-1"},
+		{name: "stringer", value: time.Second, want: "1s"},
+		{name: "other", value: 42, want: "42"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Value(tt.value, "Label"))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Package testutil provides helpers shared by the tests of each bubble.
+package testutil
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/exp/teatest"
+	"github.com/jimschubert/stripansi"
+)
+
+// Runes returns the key message for typing s.
+func Runes(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+// Key returns the key message for a key of type k.
+func Key(k tea.KeyType) tea.KeyMsg {
+	return tea.KeyMsg{Type: k}
+}
+
+// State describes the messages sent to a model before its view is compared to a golden file.
+type State struct {
+	Name   string
+	Inputs []tea.Msg
+	// WaitFor holds the final view until the output contains the text, e.g. the result of an asynchronous command.
+	WaitFor string
+}
+
+// RequireGoldenView runs model as a program, sends the inputs of s, and compares the final view (without ANSI
+// sequences) to testdata/<test name>.golden. Run the tests with -update to regenerate golden files.
+func RequireGoldenView(t *testing.T, model tea.Model, s State) tea.Model {
+	t.Helper()
+	tm := teatest.NewTestModel(t, model, teatest.WithInitialTermSize(120, 40))
+	for _, msg := range s.Inputs {
+		tm.Send(msg)
+	}
+	if s.WaitFor != "" {
+		teatest.WaitFor(t, tm.Output(), func(bts []byte) bool {
+			return bytes.Contains(stripansi.Bytes(bts), []byte(s.WaitFor))
+		}, teatest.WithDuration(3*time.Second))
+	}
+	if err := tm.Quit(); err != nil {
+		t.Fatal(err)
+	}
+
+	final := tm.FinalModel(t, teatest.WithFinalTimeout(2*time.Second))
+	teatest.RequireEqualOutput(t, stripansi.Bytes([]byte(final.View())))
+	return final
+}
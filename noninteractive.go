@@ -0,0 +1,234 @@
+package answer
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jimschubert/answer/confirm"
+	"github.com/jimschubert/answer/input"
+	"golang.org/x/term"
+)
+
+// Source resolves answers by question name when asking non-interactively
+type Source interface {
+	// Lookup returns the value for the named question, and whether the value was found
+	Lookup(name string) (any, bool)
+}
+
+// Values is a Source of preset values keyed by question name.
+//
+// Supported values are a string for input, textarea, editor, password and pathpicker questions; a number or numeric
+// string for number questions; a time.Time or string formatted with the Layout for calendar questions; a []string,
+// []any or comma-separated string for selection, tree and rank questions; and a bool, confirm.Decision or string (e.g.
+// "y", "no", "true", or empty when undecided) for confirm questions.
+type Values map[string]any
+
+// Lookup satisfies the Source interface
+func (v Values) Lookup(name string) (any, bool) {
+	value, ok := v[name]
+	return value, ok
+}
+
+type envSource struct {
+	prefix string
+}
+
+// Lookup satisfies the Source interface
+func (e envSource) Lookup(name string) (any, bool) {
+	return os.LookupEnv(EnvName(e.prefix, name))
+}
+
+// Env returns a Source which resolves values from environment variables named via EnvName.
+func Env(prefix string) Source {
+	return envSource{prefix: prefix}
+}
+
+// EnvName returns the environment variable name for a question: the prefix followed by the upper-cased question name,
+// with any character other than a letter or digit replaced by an underscore. For example, the question "proxy-url"
+// with prefix "APP_" is resolved from APP_PROXY_URL.
+func EnvName(prefix, name string) string {
+	return prefix + strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToUpper(r)
+		}
+		return '_'
+	}, name)
+}
+
+// Resolve answers each question without user interaction, using the first of the sources containing a value for the
+// question's name. When no source contains a value, the default of the question is used where one exists
+// (e.g. confirm.Model's DefaultValue). Resolved values are validated just as those entered by the user.
+//
+// An error naming the question is returned when no value can be resolved or the resolved value is invalid.
+func (m *Model) Resolve(sources ...Source) error {
+	if err := m.reset(); err != nil {
+		return err
+	}
+
+	for ; m.current < len(m.Questions); m.current++ {
+		q := m.Questions[m.current]
+		if q.When != nil && !q.When(m.answers) {
+			m.answers[q.Name] = Answer{Skipped: true}
+			continue
+		}
+
+		q.Prompt.Init()
+		m.started[m.current] = true
+		if err := resolve(q, sources); err != nil {
+			m.err = fmt.Errorf("question %q: %w", q.Name, err)
+			return m.err
+		}
+		m.answers[q.Name] = answerOf(q.Prompt)
+	}
+	m.done = true
+	return nil
+}
+
+func resolve(q Question, sources []Source) error {
+	for _, source := range sources {
+		if value, ok := source.Lookup(q.Name); ok {
+			return apply(q.Prompt, value)
+		}
+	}
+
+	if p, ok := q.Prompt.(*confirm.Model); ok && p.DefaultValue != confirm.Undecided {
+		p.SetDecision(p.DefaultValue)
+		return nil
+	}
+	if p, ok := q.Prompt.(*input.Model); ok && p.Default != "" {
+		return apply(p, p.Default)
+	}
+	return errors.New("no value available")
+}
+
+// apply sets value on the prompt, validating as the prompt would for user input. Each prompt resolves the value
+// itself, e.g. via input.Model's Resolve, number.Model's SetValue or selection.Model's SelectValues.
+func apply(prompt tea.Model, value any) error {
+	if t, ok := value.(time.Time); ok {
+		if p, ok := prompt.(interface{ SetTime(time.Time) error }); ok {
+			return p.SetTime(t)
+		}
+	}
+
+	switch p := prompt.(type) {
+	case *confirm.Model:
+		decision, err := toDecision(p, value)
+		if err != nil {
+			return err
+		}
+		p.SetDecision(decision)
+		return nil
+	case interface{ SelectValues(...string) error }:
+		values, err := toStrings(value)
+		if err != nil {
+			return err
+		}
+		return p.SelectValues(values...)
+	case interface{ SetOrder(...string) error }:
+		values, err := toStrings(value)
+		if err != nil {
+			return err
+		}
+		return p.SetOrder(values...)
+	case interface{ Resolve(string) error }:
+		text, err := toString(value)
+		if err != nil {
+			return err
+		}
+		return p.Resolve(text)
+	case interface{ SetValue(string) error }:
+		text, err := toString(value)
+		if err != nil {
+			return err
+		}
+		return p.SetValue(text)
+	case interface{ SetValue(string) }:
+		text, err := toString(value)
+		if err != nil {
+			return err
+		}
+		p.SetValue(text)
+		return nil
+	}
+	return fmt.Errorf("unsupported prompt %T", prompt)
+}
+
+func toString(value any) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case fmt.Stringer:
+		return v.String(), nil
+	case float64:
+		// JSON numbers are decoded as float64, which fmt would format in exponent notation (e.g. 1e+06)
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case bool, int, int64, uint64:
+		return fmt.Sprint(v), nil
+	}
+	return "", fmt.Errorf("cannot use %T as text", value)
+}
+
+func toStrings(value any) ([]string, error) {
+	switch v := value.(type) {
+	case []string:
+		return v, nil
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			text, err := toString(item)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, text)
+		}
+		return values, nil
+	case string:
+		values := make([]string, 0)
+		for _, item := range strings.Split(v, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				values = append(values, item)
+			}
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf("cannot use %T as selections", value)
+}
+
+func toDecision(m *confirm.Model, value any) (confirm.Decision, error) {
+	switch v := value.(type) {
+	case confirm.Decision:
+		return v, nil
+	case bool:
+		if v {
+			return confirm.Accepted, nil
+		}
+		return confirm.Denied, nil
+	case string:
+		text := strings.ToLower(strings.TrimSpace(v))
+		switch {
+		case text == "":
+			// recorded for questions left undecided
+			return confirm.Undecided, nil
+		case text == strings.ToLower(m.AcceptedDecisionText) || text == "yes":
+			return confirm.Accepted, nil
+		case text == strings.ToLower(m.DeniedDecisionText) || text == "no":
+			return confirm.Denied, nil
+		}
+		if b, err := strconv.ParseBool(text); err == nil {
+			return toDecision(m, b)
+		}
+		return confirm.Undecided, fmt.Errorf("invalid decision %q", v)
+	}
+	return confirm.Undecided, fmt.Errorf("cannot use %T as a decision", value)
+}
+
+// isTerminal determines whether the file is attached to a terminal
+func isTerminal(f *os.File) bool {
+	return term.IsTerminal(int(f.Fd()))
+}
@@ -0,0 +1,357 @@
+package answer
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/jimschubert/answer/calendar"
+	"github.com/jimschubert/answer/confirm"
+	"github.com/jimschubert/answer/editor"
+	"github.com/jimschubert/answer/input"
+	"github.com/jimschubert/answer/number"
+	"github.com/jimschubert/answer/password"
+	"github.com/jimschubert/answer/pathpicker"
+	"github.com/jimschubert/answer/rank"
+	"github.com/jimschubert/answer/textarea"
+	"github.com/jimschubert/answer/tree"
+	"github.com/jimschubert/answer/validate"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModel_Resolve(t *testing.T) {
+	tests := []struct {
+		name      string
+		questions func() []Question
+		env       map[string]string
+		sources   func() []Source
+		want      Answers
+		wantErr   string
+	}{
+		{
+			name:      "resolves from preset values",
+			questions: newQuestions,
+			sources: func() []Source {
+				return []Source{Values{"name": "Jim", "color": []string{"Red", "Blue"}, "pie": false}}
+			},
+			want: Answers{
+				"name":  {Value: "Jim"},
+				"color": {Values: []string{"Red", "Blue"}, Indexes: []int{0, 2}},
+				"pie":   {Value: "n", Decision: confirm.Denied},
+			},
+		},
+		{
+			name:      "resolves from environment variables and defaults",
+			questions: newQuestions,
+			env:       map[string]string{"APP_NAME": "Jim", "APP_COLOR": "Green, Blue"},
+			sources: func() []Source {
+				return []Source{Env("APP_")}
+			},
+			want: Answers{
+				"name":  {Value: "Jim"},
+				"color": {Values: []string{"Green", "Blue"}, Indexes: []int{1, 2}},
+				"pie":   {Value: "y", Decision: confirm.Accepted},
+			},
+		},
+		{
+			name:      "prefers earlier sources",
+			questions: newConditionalQuestions,
+			env:       map[string]string{"APP_USEPROXY": "no"},
+			sources: func() []Source {
+				return []Source{Values{"useProxy": "yes", "proxy": "http://localhost:3128"}, Env("APP_")}
+			},
+			want: Answers{
+				"useProxy": {Value: "y", Decision: confirm.Accepted},
+				"proxy":    {Value: "http://localhost:3128"},
+			},
+		},
+		{
+			name:      "skips conditional questions",
+			questions: newConditionalQuestions,
+			env:       map[string]string{"APP_USEPROXY": "false"},
+			sources: func() []Source {
+				return []Source{Env("APP_")}
+			},
+			want: Answers{
+				"useProxy": {Value: "n", Decision: confirm.Denied},
+				"proxy":    {Skipped: true},
+			},
+		},
+		{
+			name: "resolves tree selections",
+			questions: func() []Question {
+				files := tree.New()
+				files.Roots = []*tree.Node{{Label: "cmd", Children: []*tree.Node{{Label: "main.go"}, {Label: "tool.go"}}}}
+				return []Question{{Name: "files", Prompt: &files}}
+			},
+			sources: func() []Source {
+				return []Source{Values{"files": "cmd"}}
+			},
+			want: Answers{
+				"files": {Values: []string{"main.go", "tool.go"}},
+			},
+		},
+		{
+			name: "resolves rankings",
+			questions: func() []Question {
+				features := rank.New()
+				features.Choices = []string{"Export", "Sharing", "Plugins"}
+				return []Question{{Name: "features", Prompt: &features}}
+			},
+			sources: func() []Source {
+				return []Source{Values{"features": "Plugins,Export,Sharing"}}
+			},
+			want: Answers{
+				"features": {Values: []string{"Plugins", "Export", "Sharing"}, Indexes: []int{2, 0, 1}},
+			},
+		},
+		{
+			name: "resolves input defaults",
+			questions: func() []Question {
+				name := input.New()
+				name.Default = "Jim"
+				color := input.New()
+				color.Default = "blue"
+				return []Question{{Name: "name", Prompt: &name}, {Name: "color", Prompt: &color}}
+			},
+			sources: func() []Source {
+				return []Source{Values{"name": ""}}
+			},
+			want: Answers{
+				"name":  {Value: "Jim"},
+				"color": {Value: "blue"},
+			},
+		},
+		{
+			name: "validates input values asynchronously",
+			questions: func() []Question {
+				username := input.New()
+				username.Validate = input.ValidateFunc(validate.NewValidation().MinLength(2, "min: 2 characters"))
+				username.ValidateAsync = func(ctx context.Context, value string) error {
+					return errors.New("username is taken")
+				}
+				return []Question{{Name: "username", Prompt: &username}}
+			},
+			sources: func() []Source {
+				return []Source{Values{"username": "jim"}}
+			},
+			wantErr: `question "username": username is taken`,
+		},
+		{
+			name: "validates textarea values",
+			questions: func() []Question {
+				message := textarea.New()
+				message.Validate = textarea.ValidateFunc(validate.NewValidation().MinLength(10, "min: 10 characters"))
+				return []Question{{Name: "message", Prompt: &message}}
+			},
+			sources: func() []Source {
+				return []Source{Values{"message": "Fix\n"}}
+			},
+			wantErr: `question "message": min: 10 characters`,
+		},
+		{
+			name: "resolves editor values",
+			questions: func() []Question {
+				description := editor.New()
+				description.Validate = editor.ValidateFunc(validate.NewValidation().MinLength(10, "min: 10 characters"))
+				return []Question{{Name: "description", Prompt: &description}}
+			},
+			sources: func() []Source {
+				return []Source{Values{"description": "Adds an editor\nprompt"}}
+			},
+			want: Answers{
+				"description": {Value: "Adds an editor\nprompt"},
+			},
+		},
+		{
+			name: "validates password values",
+			questions: func() []Question {
+				secret := password.New()
+				secret.Validate = password.ValidateFunc(validate.NewValidation().Strength(3, "password is too weak"))
+				return []Question{{Name: "secret", Prompt: &secret}}
+			},
+			sources: func() []Source {
+				return []Source{Values{"secret": "hunter2"}}
+			},
+			wantErr: `question "secret": password is too weak`,
+		},
+		{
+			name: "resolves numbers",
+			questions: func() []Question {
+				port := number.New()
+				port.Min = 1024
+				port.Max = 65535
+				return []Question{{Name: "port", Prompt: &port}}
+			},
+			sources: func() []Source {
+				return []Source{Values{"port": 8080}}
+			},
+			want: Answers{
+				"port": {Value: "8080"},
+			},
+		},
+		{
+			name: "reports numbers out of bounds",
+			questions: func() []Question {
+				port := number.New()
+				port.Min = 1024
+				port.Max = 65535
+				return []Question{{Name: "port", Prompt: &port}}
+			},
+			sources: func() []Source {
+				return []Source{Values{"port": "80"}}
+			},
+			wantErr: `question "port": minimum value allowed=1024 actual=80`,
+		},
+		{
+			name: "resolves calendar dates",
+			questions: func() []Question {
+				due := calendar.New()
+				due.Location = time.UTC
+				return []Question{{Name: "due", Prompt: &due}}
+			},
+			sources: func() []Source {
+				return []Source{Values{"due": "2024-06-01"}}
+			},
+			want: Answers{
+				"due": {Value: "2024-06-01"},
+			},
+		},
+		{
+			name: "reports calendar dates out of bounds",
+			questions: func() []Question {
+				due := calendar.New()
+				due.Location = time.UTC
+				due.Min = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+				return []Question{{Name: "due", Prompt: &due}}
+			},
+			sources: func() []Source {
+				return []Source{Values{"due": time.Date(2023, time.June, 1, 12, 0, 0, 0, time.UTC)}}
+			},
+			wantErr: `question "due": 2023-06-01 is before the minimum allowed 2024-01-01`,
+		},
+		{
+			name: "validates paths",
+			questions: func() []Question {
+				config := pathpicker.New()
+				config.Validate = pathpicker.ValidateFunc(validate.NewValidation().Extension([]string{".yaml"}))
+				return []Question{{Name: "config", Prompt: &config}}
+			},
+			sources: func() []Source {
+				return []Source{Values{"config": "config.json"}}
+			},
+			wantErr: `question "config": "config.json" must have one of the extensions .yaml`,
+		},
+		{
+			name:      "reports questions without values",
+			questions: newQuestions,
+			sources: func() []Source {
+				return []Source{Values{"color": "Red"}}
+			},
+			wantErr: `question "name": no value available`,
+		},
+		{
+			name:      "reports invalid choices",
+			questions: newQuestions,
+			sources: func() []Source {
+				return []Source{Values{"name": "Jim", "color": "Red,Purple"}}
+			},
+			wantErr: `question "color": invalid choice "Purple"`,
+		},
+		{
+			name:      "reports invalid decisions",
+			questions: newQuestions,
+			sources: func() []Source {
+				return []Source{Values{"name": "Jim", "color": "Red", "pie": "maybe"}}
+			},
+			wantErr: `question "pie": invalid decision "maybe"`,
+		},
+		{
+			name: "reports validation errors",
+			questions: func() []Question {
+				name := input.New()
+				name.Validate = input.ValidateFunc(validate.NewValidation().MinLength(2, "min: 2 characters"))
+				return []Question{{Name: "name", Prompt: &name}}
+			},
+			sources: func() []Source {
+				return []Source{Values{"name": "J"}}
+			},
+			wantErr: `question "name": min: 2 characters`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			m := New()
+			m.Questions = tt.questions()
+			err := m.Resolve(tt.sources()...)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, m.Answers())
+		})
+	}
+}
+
+func TestAsk_nonInteractive(t *testing.T) {
+	answers, err := Ask(newQuestions(), WithNonInteractive(true), WithSources(Values{"name": "Jim", "color": "Red"}))
+	assert.NoError(t, err)
+	assert.Equal(t, Answers{
+		"name":  {Value: "Jim"},
+		"color": {Values: []string{"Red"}, Indexes: []int{0}},
+		"pie":   {Value: "y", Decision: confirm.Accepted},
+	}, answers)
+
+	_, err = Ask(newQuestions(), WithNonInteractive(true))
+	assert.EqualError(t, err, `question "name": no value available`)
+}
+
+func TestAsk_terminalDetection(t *testing.T) {
+	file, err := os.CreateTemp(t.TempDir(), "stdin-*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = file.Close()
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{name: "null device", path: os.DevNull},
+		{name: "regular file", path: file.Name()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stdin, err := os.Open(tt.path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer stdin.Close()
+			original := os.Stdin
+			os.Stdin = stdin
+			defer func() {
+				os.Stdin = original
+			}()
+
+			answers, err := Ask(newQuestions(), WithTerminalDetection(true), WithSources(Values{"name": "Jim", "color": "Red"}))
+			assert.NoError(t, err)
+			assert.Equal(t, Answers{
+				"name":  {Value: "Jim"},
+				"color": {Values: []string{"Red"}, Indexes: []int{0}},
+				"pie":   {Value: "y", Decision: confirm.Accepted},
+			}, answers)
+		})
+	}
+}
+
+func TestEnvName(t *testing.T) {
+	assert.Equal(t, "APP_PROXY_URL", EnvName("APP_", "proxy-url"))
+	assert.Equal(t, "USEPROXY", EnvName("", "useProxy"))
+}
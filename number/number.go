@@ -0,0 +1,346 @@
+package number
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jimschubert/answer/colors"
+	"github.com/jimschubert/answer/internal/render"
+	"github.com/jimschubert/answer/outcome"
+)
+
+var (
+	_ tea.Model = (*Model)(nil)
+)
+
+var (
+	// partialInteger and partialFloat match text which is, or may become while typing, a number
+	partialInteger = regexp.MustCompile(`^-?\d*$`)
+	partialFloat   = regexp.MustCompile(`^-?\d*\.?\d*$`)
+)
+
+// ErrRequired is displayed when submitting without a number
+var ErrRequired = errors.New("a number is required")
+
+// Styles holds relevant styles used for rendering
+// For an introduction to styling with Lip Gloss see:
+// https://github.com/charmbracelet/lipgloss
+type Styles struct {
+	PromptPrefix lipgloss.Style
+	Prompt       lipgloss.Style
+	ErrorPrefix  lipgloss.Style
+	Text         lipgloss.Style
+	Placeholder  lipgloss.Style
+}
+
+type KeyMap struct {
+	Increment key.Binding
+	Decrement key.Binding
+	Enter     key.Binding
+	Quit      key.Binding
+}
+
+var DefaultKeyMap = KeyMap{
+	Increment: key.NewBinding(
+		key.WithKeys(tea.KeyUp.String()),
+		key.WithHelp("↑", "increment"),
+	),
+	Decrement: key.NewBinding(
+		key.WithKeys(tea.KeyDown.String()),
+		key.WithHelp("↓", "decrement"),
+	),
+	Enter: key.NewBinding(key.WithKeys(tea.KeyEnter.String())),
+	Quit: key.NewBinding(
+		key.WithKeys(tea.KeyEsc.String(), tea.KeyCtrlC.String()),
+		key.WithHelp("esc", "quit"),
+	),
+}
+
+// Model represents the bubble tea model for a number. Only numeric keystrokes are accepted.
+type Model struct {
+	PromptPrefix string
+	Prompt       string
+	Placeholder  string
+	// Float accepts decimal numbers, otherwise only integers are accepted
+	Float bool
+	// Min and Max are the inclusive bounds of the number
+	Min float64
+	Max float64
+	// Step is the amount added or subtracted via the Increment and Decrement keys
+	Step        float64
+	MaxWidth    int
+	Styles      Styles
+	KeyMap      KeyMap
+	err         error
+	outcome     outcome.Outcome
+	input       textinput.Model
+	initialized bool
+}
+
+// New creates a new model with default settings.
+func New() Model {
+	return Model{
+		PromptPrefix: "? ",
+		Min:          -math.MaxFloat64,
+		Max:          math.MaxFloat64,
+		Step:         1,
+		KeyMap:       DefaultKeyMap,
+		Styles: Styles{
+			PromptPrefix: lipgloss.NewStyle().Foreground(lipgloss.Color(colors.PromptPrefix)),
+			ErrorPrefix:  lipgloss.NewStyle().Foreground(lipgloss.Color(colors.ErrorPrefix)),
+			Placeholder:  lipgloss.NewStyle().Foreground(lipgloss.Color(colors.Placeholder)),
+		},
+	}
+}
+
+func (m *Model) setup() {
+	if m.Prompt == "" {
+		m.Prompt = "Please enter a number:"
+	}
+	input := textinput.New()
+	input.Width = m.MaxWidth
+	if !strings.HasSuffix(m.Prompt, " ") {
+		input.Prompt = m.Prompt + " "
+	} else {
+		input.Prompt = m.Prompt
+	}
+	input.Placeholder = m.Placeholder
+	input.PromptStyle = m.Styles.Prompt
+	input.PlaceholderStyle = m.Styles.Placeholder
+	input.TextStyle = m.Styles.Text
+	input.Focus()
+	m.input = input
+	m.initialized = true
+}
+
+func (m *Model) Init() tea.Cmd {
+	m.setup()
+	return nil
+}
+
+// parse converts text to a number, returning an error if the text is not a number or is out of bounds
+func (m *Model) parse(text string) (float64, error) {
+	if text == "" {
+		return 0, ErrRequired
+	}
+
+	var value float64
+	if m.Float {
+		v, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid number %q", text)
+		}
+		value = v
+	} else {
+		v, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid integer %q", text)
+		}
+		value = float64(v)
+	}
+
+	if value < m.Min {
+		return value, fmt.Errorf("minimum value allowed=%s actual=%s", m.format(m.Min, -1), text)
+	}
+	if value > m.Max {
+		return value, fmt.Errorf("maximum value allowed=%s actual=%s", m.format(m.Max, -1), text)
+	}
+	return value, nil
+}
+
+// format converts value to text, with a fixed number of decimals unless decimals is negative
+func (m *Model) format(value float64, decimals int) string {
+	if !m.Float {
+		return strconv.FormatInt(int64(math.Round(value)), 10)
+	}
+	return strconv.FormatFloat(value, 'f', decimals, 64)
+}
+
+// decimals counts the digits following the decimal point of text
+func decimals(text string) int {
+	if idx := strings.IndexRune(text, '.'); idx >= 0 {
+		return len(text) - idx - 1
+	}
+	return 0
+}
+
+// step adds delta to the number, keeping the result within bounds
+func (m *Model) step(delta float64) {
+	text := m.input.Value()
+	value, _ := strconv.ParseFloat(text, 64)
+	value += delta
+	if value < m.Min {
+		value = m.Min
+	}
+	if value > m.Max {
+		value = m.Max
+	}
+
+	// avoid floating point noise (e.g. 0.30000000000000004) by keeping the precision of the step or current text
+	precision := decimals(strconv.FormatFloat(delta, 'f', -1, 64))
+	if current := decimals(text); current > precision {
+		precision = current
+	}
+	m.input.SetValue(m.format(value, precision))
+	_, m.err = m.parse(m.input.Value())
+}
+
+// filter removes any runes which would not result in a number, as if each rune were typed at the cursor
+func (m *Model) filter(runes []rune) []rune {
+	partial := partialInteger
+	if m.Float {
+		partial = partialFloat
+	}
+
+	text := []rune(m.input.Value())
+	position := m.input.Position()
+	if position > len(text) {
+		position = len(text)
+	}
+	accepted := make([]rune, 0, len(runes))
+	for _, r := range runes {
+		candidate := make([]rune, 0, len(text)+1)
+		candidate = append(candidate, text[:position]...)
+		candidate = append(candidate, r)
+		candidate = append(candidate, text[position:]...)
+		if partial.MatchString(string(candidate)) {
+			accepted = append(accepted, r)
+			text = candidate
+			position++
+		}
+	}
+	return accepted
+}
+
+// SetValue sets the number from text, returning an error if the text is not a number or is out of bounds
+func (m *Model) SetValue(value string) error {
+	if !m.initialized {
+		m.setup()
+	}
+	m.input.SetValue(value)
+	_, m.err = m.parse(value)
+	return m.err
+}
+
+// Value returns the number as entered
+func (m *Model) Value() string {
+	return m.input.Value()
+}
+
+// Int returns the number as an int64, truncating any decimals
+func (m *Model) Int() int64 {
+	if !m.Float {
+		value, _ := strconv.ParseInt(m.input.Value(), 10, 64)
+		return value
+	}
+	return int64(m.Float64())
+}
+
+// Float64 returns the number as a float64
+func (m *Model) Float64() float64 {
+	value, _ := strconv.ParseFloat(m.input.Value(), 64)
+	return value
+}
+
+// Reopen allows a submitted model to be edited again, retaining its current value and re-running validation
+func (m *Model) Reopen() {
+	m.outcome = outcome.Pending
+	if m.initialized {
+		_, m.err = m.parse(m.input.Value())
+	}
+}
+
+// Outcome indicates whether the user has submitted or cancelled the number
+func (m *Model) Outcome() outcome.Outcome {
+	return m.outcome
+}
+
+// Err returns outcome.ErrCancelled if the user cancelled the number
+func (m *Model) Err() error {
+	return m.outcome.Err()
+}
+
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if !m.initialized {
+		m.setup()
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.KeyMap.Enter):
+			if _, m.err = m.parse(m.input.Value()); m.err == nil {
+				m.outcome = outcome.Submitted
+				return m, tea.Quit
+			}
+			return m, nil
+		case key.Matches(msg, m.KeyMap.Quit):
+			m.outcome = outcome.Cancelled
+			return m, tea.Quit
+		case key.Matches(msg, m.KeyMap.Increment):
+			m.step(m.Step)
+			return m, nil
+		case key.Matches(msg, m.KeyMap.Decrement):
+			m.step(-m.Step)
+			return m, nil
+		}
+
+		if msg.Type == tea.KeyRunes || msg.Type == tea.KeySpace {
+			msg.Runes = m.filter(msg.Runes)
+			if len(msg.Runes) == 0 {
+				return m, nil
+			}
+			msg.Type = tea.KeyRunes
+		}
+
+		before := m.input.Value()
+		var cmd tea.Cmd
+		m.input, cmd = m.input.Update(msg)
+		if m.input.Value() != before {
+			_, m.err = m.parse(m.input.Value())
+		}
+		return m, cmd
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+func (m *Model) View() string {
+	var b strings.Builder
+	if m.PromptPrefix != "" {
+		b.WriteString(m.Styles.PromptPrefix.Inline(true).Render(m.PromptPrefix))
+		if m.Prompt != "" && !strings.HasSuffix(m.PromptPrefix, " ") {
+			b.WriteRune(' ')
+		}
+	}
+
+	if m.outcome == outcome.Cancelled {
+		return b.String()
+	} else if m.outcome == outcome.Submitted {
+		// rather than clearing the program output, we want to show the question + answer just as AlecAivazis/survey did
+		if m.Prompt != "" {
+			b.WriteString(m.Styles.Prompt.Inline(true).Render(m.Prompt))
+			b.WriteRune(' ')
+		}
+		b.WriteString(m.input.Value())
+		b.WriteRune('\n')
+		return b.String()
+	}
+
+	b.WriteString(m.input.View())
+	if m.err != nil {
+		b.WriteRune('\n')
+		render.WriteError(&b, m.err, m.Styles.ErrorPrefix, m.Styles.Placeholder)
+	}
+	return b.String()
+}
@@ -0,0 +1,241 @@
+package number
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jimschubert/answer/internal/testutil"
+	"github.com/jimschubert/answer/outcome"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModel_View(t *testing.T) {
+	enter := testutil.Key(tea.KeyEnter)
+	tests := []struct {
+		name   string
+		setup  func(m *Model)
+		states []testutil.State
+	}{
+		{
+			name: "number",
+			states: []testutil.State{
+				{Name: "displays typed number", Inputs: []tea.Msg{testutil.Runes("8080")}},
+				{Name: "displays submitted number", Inputs: []tea.Msg{testutil.Runes("8080"), enter}},
+				{Name: "requires a number", Inputs: []tea.Msg{enter}},
+				{Name: "rejects a partial number", Inputs: []tea.Msg{testutil.Runes("-"), enter}},
+				{Name: "clears on cancel", Inputs: []tea.Msg{testutil.Runes("3"), testutil.Key(tea.KeyEsc)}},
+			},
+		},
+		{
+			name: "bounded number",
+			setup: func(m *Model) {
+				m.Prompt = "Port:"
+				m.Min = 1024
+				m.Max = 65535
+			},
+			states: []testutil.State{
+				{Name: "displays minimum message", Inputs: []tea.Msg{testutil.Runes("80"), enter}},
+				{Name: "displays maximum message", Inputs: []tea.Msg{testutil.Runes("65536"), enter}},
+			},
+		},
+		{
+			name: "float",
+			setup: func(m *Model) {
+				m.Float = true
+				m.Step = 0.1
+			},
+			states: []testutil.State{
+				{Name: "steps up", Inputs: []tea.Msg{testutil.Key(tea.KeyUp), testutil.Key(tea.KeyUp), testutil.Key(tea.KeyUp)}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		for _, s := range tt.states {
+			t.Run(tt.name+"_"+s.Name, func(t *testing.T) {
+				m := New()
+				if tt.setup != nil {
+					tt.setup(&m)
+				}
+				testutil.RequireGoldenView(t, &m, s)
+			})
+		}
+	}
+}
+
+func TestModel_Update(t *testing.T) {
+	enter := testutil.Key(tea.KeyEnter)
+	up := testutil.Key(tea.KeyUp)
+	down := testutil.Key(tea.KeyDown)
+	tests := []struct {
+		name        string
+		setup       func(m *Model)
+		inputs      []tea.KeyMsg
+		want        string
+		wantInt     int64
+		wantFloat   float64
+		wantOutcome outcome.Outcome
+		wantErr     error
+	}{
+		{
+			name:        "accepts integers",
+			inputs:      []tea.KeyMsg{testutil.Runes("8080"), enter},
+			want:        "8080",
+			wantInt:     8080,
+			wantFloat:   8080,
+			wantOutcome: outcome.Submitted,
+		},
+		{
+			name:        "ignores non-numeric keystrokes",
+			inputs:      []tea.KeyMsg{testutil.Runes("8a0"), {Type: tea.KeySpace, Runes: []rune{' '}}, testutil.Runes("8.0")},
+			want:        "8080",
+			wantInt:     8080,
+			wantFloat:   8080,
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name:        "accepts a leading minus sign only",
+			inputs:      []tea.KeyMsg{testutil.Runes("-3-2")},
+			want:        "-32",
+			wantInt:     -32,
+			wantFloat:   -32,
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name: "accepts a single decimal point in float mode",
+			setup: func(m *Model) {
+				m.Float = true
+			},
+			inputs:      []tea.KeyMsg{testutil.Runes("2.5.0"), enter},
+			want:        "2.50",
+			wantInt:     2,
+			wantFloat:   2.5,
+			wantOutcome: outcome.Submitted,
+		},
+		{
+			name: "increments and decrements by step",
+			setup: func(m *Model) {
+				m.Step = 5
+			},
+			inputs:      []tea.KeyMsg{testutil.Runes("10"), up, up, down},
+			want:        "15",
+			wantInt:     15,
+			wantFloat:   15,
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name: "increments floats without rounding noise",
+			setup: func(m *Model) {
+				m.Float = true
+				m.Step = 0.1
+			},
+			inputs:      []tea.KeyMsg{up, up, up},
+			want:        "0.3",
+			wantFloat:   0.3,
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name: "keeps steps within bounds",
+			setup: func(m *Model) {
+				m.Min = 1
+				m.Max = 3
+			},
+			inputs:      []tea.KeyMsg{down, up, up, up, up},
+			want:        "3",
+			wantInt:     3,
+			wantFloat:   3,
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name: "does not submit a number below the minimum",
+			setup: func(m *Model) {
+				m.Prompt = "Port:"
+				m.Min = 1024
+				m.Max = 65535
+			},
+			inputs:      []tea.KeyMsg{testutil.Runes("80"), enter},
+			want:        "80",
+			wantInt:     80,
+			wantFloat:   80,
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name: "does not submit a number above the maximum",
+			setup: func(m *Model) {
+				m.Float = true
+				m.Max = 1.5
+			},
+			inputs:      []tea.KeyMsg{testutil.Runes("1.75"), enter},
+			want:        "1.75",
+			wantInt:     1,
+			wantFloat:   1.75,
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name:        "does not submit without a number",
+			inputs:      []tea.KeyMsg{enter},
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name:        "does not submit a partial number",
+			inputs:      []tea.KeyMsg{testutil.Runes("-"), enter},
+			want:        "-",
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name:        "cancelled via esc",
+			inputs:      []tea.KeyMsg{testutil.Runes("3"), testutil.Key(tea.KeyEsc)},
+			want:        "3",
+			wantInt:     3,
+			wantFloat:   3,
+			wantOutcome: outcome.Cancelled,
+			wantErr:     outcome.ErrCancelled,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			if tt.setup != nil {
+				tt.setup(&m)
+			}
+			m.Init()
+			for _, msg := range tt.inputs {
+				m.Update(msg)
+			}
+
+			assert.Equal(t, tt.want, m.Value())
+			assert.Equal(t, tt.wantInt, m.Int())
+			assert.Equal(t, tt.wantFloat, m.Float64())
+			assert.Equal(t, tt.wantOutcome, m.Outcome())
+			assert.ErrorIs(t, m.Err(), tt.wantErr)
+		})
+	}
+}
+
+func TestModel_SetValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		float   bool
+		value   string
+		wantErr string
+	}{
+		{name: "integer", value: "42"},
+		{name: "float", float: true, value: "4.2"},
+		{name: "float in integer mode", value: "4.2", wantErr: `invalid integer "4.2"`},
+		{name: "out of bounds", value: "101", wantErr: "maximum value allowed=100 actual=101"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.Float = tt.float
+			m.Min = 0
+			m.Max = 100
+			err := m.SetValue(tt.value)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+			assert.Equal(t, tt.value, m.Value())
+		})
+	}
+}
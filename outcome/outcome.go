@@ -0,0 +1,44 @@
+package outcome
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrCancelled indicates the user cancelled rather than submitted their answer
+var ErrCancelled = errors.New("cancelled by user")
+
+// Outcome is an enumeration of the ways in which a user may finish answering a bubble
+type Outcome int8
+
+const (
+	// Pending indicates the user has neither submitted nor cancelled
+	Pending Outcome = iota
+
+	// Submitted indicates the user has provided their answer (e.g. via enter)
+	Submitted
+
+	// Cancelled indicates the user has quit without providing an answer (e.g. via esc or ctrl+c)
+	Cancelled
+)
+
+// String satisfies the fmt.Stringer interface
+func (o Outcome) String() string {
+	names := [...]string{
+		"pending",
+		"submitted",
+		"cancelled",
+	}
+	if o < 0 || int(o) >= len(names) {
+		return fmt.Sprintf("Outcome(%d)", o)
+	}
+	return names[o]
+}
+
+// Err returns ErrCancelled for the Cancelled outcome, and nil otherwise
+func (o Outcome) Err() error {
+	if o == Cancelled {
+		return ErrCancelled
+	}
+	return nil
+}
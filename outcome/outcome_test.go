@@ -0,0 +1,42 @@
+package outcome
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutcome_Err(t *testing.T) {
+	tests := []struct {
+		name    string
+		outcome Outcome
+		want    error
+	}{
+		{name: "pending", outcome: Pending, want: nil},
+		{name: "submitted", outcome: Submitted, want: nil},
+		{name: "cancelled", outcome: Cancelled, want: ErrCancelled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.outcome.Err(), tt.want)
+			assert.Equal(t, tt.name, tt.outcome.String())
+		})
+	}
+}
+
+func TestOutcome_String(t *testing.T) {
+	tests := []struct {
+		name    string
+		outcome Outcome
+		want    string
+	}{
+		{name: "known", outcome: Submitted, want: "submitted"},
+		{name: "out of range", outcome: Outcome(3), want: "Outcome(3)"},
+		{name: "negative", outcome: Outcome(-1), want: "Outcome(-1)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.outcome.String())
+		})
+	}
+}
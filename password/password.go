@@ -0,0 +1,274 @@
+package password
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jimschubert/answer/colors"
+	"github.com/jimschubert/answer/internal/render"
+	"github.com/jimschubert/answer/outcome"
+	"github.com/jimschubert/answer/validate"
+)
+
+var (
+	_ tea.Model = (*Model)(nil)
+)
+
+// ErrMismatch is displayed when the confirmation does not match the password
+var ErrMismatch = errors.New("passwords do not match")
+
+// summaryLength is the number of mask runes displayed once submitted, regardless of the password's length
+const summaryLength = 8
+
+// ValidateFunc determines if the password is valid, returning nil if valid or an error if invalid
+type ValidateFunc validate.Func
+
+// Styles holds relevant styles used for rendering
+// For an introduction to styling with Lip Gloss see:
+// https://github.com/charmbracelet/lipgloss
+type Styles struct {
+	PromptPrefix lipgloss.Style
+	Prompt       lipgloss.Style
+	ErrorPrefix  lipgloss.Style
+	Text         lipgloss.Style
+	Placeholder  lipgloss.Style
+}
+
+type KeyMap struct {
+	Enter  key.Binding
+	Reveal key.Binding
+	Quit   key.Binding
+}
+
+var DefaultKeyMap = KeyMap{
+	Enter: key.NewBinding(key.WithKeys(tea.KeyEnter.String())),
+	Reveal: key.NewBinding(
+		key.WithKeys(tea.KeyCtrlR.String()),
+		key.WithHelp("ctrl+r", "reveal/hide"),
+	),
+	Quit: key.NewBinding(
+		key.WithKeys(tea.KeyEsc.String(), tea.KeyCtrlC.String()),
+		key.WithHelp("esc", "quit"),
+	),
+}
+
+// Model represents the bubble tea model for a masked password
+type Model struct {
+	PromptPrefix string
+	Prompt       string
+	// Confirm requires the password to be entered a second time, prompted by ConfirmPrompt
+	Confirm       bool
+	ConfirmPrompt string
+	Placeholder   string
+	Mask          rune
+	CharLimit     int
+	MaxWidth      int
+	Validate      ValidateFunc
+	Styles        Styles
+	KeyMap        KeyMap
+	value         string
+	confirming    bool
+	revealed      bool
+	err           error
+	outcome       outcome.Outcome
+	input         textinput.Model
+	initialized   bool
+}
+
+// New creates a new model with default settings.
+func New() Model {
+	return Model{
+		PromptPrefix: "? ",
+		Mask:         '*',
+		KeyMap:       DefaultKeyMap,
+		Styles: Styles{
+			PromptPrefix: lipgloss.NewStyle().Foreground(lipgloss.Color(colors.PromptPrefix)),
+			ErrorPrefix:  lipgloss.NewStyle().Foreground(lipgloss.Color(colors.ErrorPrefix)),
+			Placeholder:  lipgloss.NewStyle().Foreground(lipgloss.Color(colors.Placeholder)),
+		},
+	}
+}
+
+func (m *Model) setup() {
+	if m.Validate == nil {
+		m.Validate = ValidateFunc(validate.NewValidation())
+	}
+	if m.Prompt == "" {
+		m.Prompt = "Please enter password:"
+	}
+	if m.ConfirmPrompt == "" {
+		m.ConfirmPrompt = "Confirm password:"
+	}
+	input := textinput.New()
+	input.CharLimit = m.CharLimit
+	input.Width = m.MaxWidth
+	input.Placeholder = m.Placeholder
+	input.PromptStyle = m.Styles.Prompt
+	input.PlaceholderStyle = m.Styles.Placeholder
+	input.TextStyle = m.Styles.Text
+	input.EchoMode = textinput.EchoPassword
+	input.EchoCharacter = m.Mask
+	input.Focus()
+	m.input = input
+	m.setPrompt(m.Prompt)
+	m.initialized = true
+}
+
+func (m *Model) setPrompt(prompt string) {
+	if !strings.HasSuffix(prompt, " ") {
+		prompt += " "
+	}
+	m.input.Prompt = prompt
+}
+
+func (m *Model) Init() tea.Cmd {
+	m.setup()
+	return nil
+}
+
+// SetValue sets the password, bypassing any confirmation
+func (m *Model) SetValue(value string) {
+	m.value = value
+	m.input.SetValue(value)
+}
+
+// Resolve sets the value as though entered by the user, returning the error from Validate, if any. This allows the
+// password to be answered without user interaction.
+func (m *Model) Resolve(value string) error {
+	m.SetValue(value)
+	if m.Validate != nil {
+		return m.Validate(value)
+	}
+	return nil
+}
+
+func (m *Model) Value() string {
+	return m.value
+}
+
+// Reopen allows a submitted model to be edited again, retaining its current value and re-running validation
+func (m *Model) Reopen() {
+	m.outcome = outcome.Pending
+	if m.initialized {
+		m.confirming = false
+		m.setPrompt(m.Prompt)
+		m.input.SetValue(m.value)
+		m.input.Focus()
+		m.err = m.Validate(m.value)
+	}
+}
+
+// Outcome indicates whether the user has submitted or cancelled the password
+func (m *Model) Outcome() outcome.Outcome {
+	return m.outcome
+}
+
+// Err returns outcome.ErrCancelled if the user cancelled the password
+func (m *Model) Err() error {
+	return m.outcome.Err()
+}
+
+// enter accepts the password, moving on to the confirmation when required
+func (m *Model) enter() tea.Cmd {
+	if !m.confirming {
+		if m.err = m.Validate(m.input.Value()); m.err != nil {
+			return nil
+		}
+		m.value = m.input.Value()
+		if m.Confirm {
+			m.confirming = true
+			m.setPrompt(m.ConfirmPrompt)
+			m.input.Reset()
+			return nil
+		}
+	} else if m.input.Value() != m.value {
+		// start over, as either entry may contain the typo
+		m.err = ErrMismatch
+		m.value = ""
+		m.confirming = false
+		m.setPrompt(m.Prompt)
+		m.input.Reset()
+		return nil
+	}
+
+	m.confirming = false
+	m.revealed = false
+	m.input.EchoMode = textinput.EchoPassword
+	m.input.Reset()
+	m.input.Blur()
+	m.outcome = outcome.Submitted
+	return tea.Quit
+}
+
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if !m.initialized {
+		m.setup()
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.KeyMap.Enter):
+			return m, m.enter()
+		case key.Matches(msg, m.KeyMap.Reveal):
+			m.revealed = !m.revealed
+			if m.revealed {
+				m.input.EchoMode = textinput.EchoNormal
+			} else {
+				m.input.EchoMode = textinput.EchoPassword
+			}
+			return m, nil
+		case key.Matches(msg, m.KeyMap.Quit):
+			m.outcome = outcome.Cancelled
+			m.input.Reset()
+			m.input.Blur()
+			return m, tea.Quit
+		}
+	}
+
+	var cmd tea.Cmd
+	before := m.input.Value()
+	m.input, cmd = m.input.Update(msg)
+	if m.input.Value() != before {
+		if m.confirming {
+			m.err = nil
+		} else {
+			m.err = m.Validate(m.input.Value())
+		}
+	}
+	return m, cmd
+}
+
+func (m *Model) View() string {
+	var b strings.Builder
+	if m.PromptPrefix != "" {
+		b.WriteString(m.Styles.PromptPrefix.Inline(true).Render(m.PromptPrefix))
+		if m.Prompt != "" && !strings.HasSuffix(m.PromptPrefix, " ") {
+			b.WriteRune(' ')
+		}
+	}
+
+	if m.outcome == outcome.Cancelled {
+		return b.String()
+	} else if m.outcome == outcome.Submitted {
+		// a fixed-length mask, so neither the password nor its length is left in the terminal
+		if m.Prompt != "" {
+			b.WriteString(m.Styles.Prompt.Inline(true).Render(m.Prompt))
+			b.WriteRune(' ')
+		}
+		b.WriteString(m.Styles.Placeholder.Inline(true).Render(strings.Repeat(string(m.Mask), summaryLength)))
+		b.WriteRune('\n')
+		return b.String()
+	}
+
+	b.WriteString(m.input.View())
+	if m.err != nil {
+		b.WriteRune('\n')
+		render.WriteError(&b, m.err, m.Styles.ErrorPrefix, m.Styles.Placeholder)
+	}
+	return b.String()
+}
@@ -0,0 +1,175 @@
+package password
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jimschubert/answer/internal/testutil"
+	"github.com/jimschubert/answer/outcome"
+	"github.com/jimschubert/answer/validate"
+	"github.com/jimschubert/stripansi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModel_View(t *testing.T) {
+	enter := testutil.Key(tea.KeyEnter)
+	reveal := testutil.Key(tea.KeyCtrlR)
+	tests := []struct {
+		name   string
+		setup  func(m *Model)
+		states []testutil.State
+	}{
+		{
+			name: "password",
+			states: []testutil.State{
+				{Name: "masks the password", Inputs: []tea.Msg{testutil.Runes("hunter2")}},
+				{Name: "reveals the password", Inputs: []tea.Msg{testutil.Runes("hunter2"), reveal}},
+				{Name: "hides a revealed password", Inputs: []tea.Msg{testutil.Runes("hunter2"), reveal, reveal}},
+				{Name: "masks a fixed length once submitted", Inputs: []tea.Msg{testutil.Runes("hunter2"), reveal, enter}},
+				{Name: "clears on cancel", Inputs: []tea.Msg{testutil.Runes("hunter2"), testutil.Key(tea.KeyEsc)}},
+			},
+		},
+		{
+			name: "password with custom mask",
+			setup: func(m *Model) {
+				m.Mask = '•'
+			},
+			states: []testutil.State{
+				{Name: "masks with the rune", Inputs: []tea.Msg{testutil.Runes("hunter2")}},
+			},
+		},
+		{
+			name: "confirmable password",
+			setup: func(m *Model) {
+				m.Confirm = true
+			},
+			states: []testutil.State{
+				{Name: "prompts for confirmation", Inputs: []tea.Msg{testutil.Runes("hunter2"), enter}},
+				{Name: "displays mismatch message", Inputs: []tea.Msg{testutil.Runes("hunter2"), enter, testutil.Runes("hunter3"), enter}},
+			},
+		},
+		{
+			name: "validatable password",
+			setup: func(m *Model) {
+				m.Validate = ValidateFunc(validate.NewValidation().Strength(3, "password is too weak"))
+			},
+			states: []testutil.State{
+				{Name: "displays validation message", Inputs: []tea.Msg{testutil.Runes("hunter2"), enter}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		for _, s := range tt.states {
+			t.Run(tt.name+"_"+s.Name, func(t *testing.T) {
+				m := New()
+				if tt.setup != nil {
+					tt.setup(&m)
+				}
+				testutil.RequireGoldenView(t, &m, s)
+			})
+		}
+	}
+}
+
+func TestModel_Update(t *testing.T) {
+	enter := testutil.Key(tea.KeyEnter)
+	reveal := testutil.Key(tea.KeyCtrlR)
+	tests := []struct {
+		name          string
+		setup         func(m *Model)
+		inputs        []tea.KeyMsg
+		want          string
+		wantOutcome   outcome.Outcome
+		wantErr       error
+		wantNotInView string
+	}{
+		{
+			name:          "masks the password",
+			inputs:        []tea.KeyMsg{testutil.Runes("hunter2")},
+			wantOutcome:   outcome.Pending,
+			wantNotInView: "hunter2",
+		},
+		{
+			name:          "hides a revealed password",
+			inputs:        []tea.KeyMsg{testutil.Runes("hunter2"), reveal, reveal},
+			wantOutcome:   outcome.Pending,
+			wantNotInView: "hunter2",
+		},
+		{
+			name:          "never shows the password once submitted",
+			inputs:        []tea.KeyMsg{testutil.Runes("hunter2"), reveal, enter},
+			want:          "hunter2",
+			wantOutcome:   outcome.Submitted,
+			wantNotInView: "hunter2",
+		},
+		{
+			name: "prompts for confirmation",
+			setup: func(m *Model) {
+				m.Confirm = true
+			},
+			inputs:        []tea.KeyMsg{testutil.Runes("hunter2"), enter},
+			want:          "hunter2",
+			wantOutcome:   outcome.Pending,
+			wantNotInView: "*",
+		},
+		{
+			name: "submits a matching confirmation",
+			setup: func(m *Model) {
+				m.Confirm = true
+			},
+			inputs:      []tea.KeyMsg{testutil.Runes("hunter2"), enter, testutil.Runes("hunter2"), enter},
+			want:        "hunter2",
+			wantOutcome: outcome.Submitted,
+		},
+		{
+			name: "starts over when the confirmation does not match",
+			setup: func(m *Model) {
+				m.Confirm = true
+			},
+			inputs:      []tea.KeyMsg{testutil.Runes("hunter2"), enter, testutil.Runes("hunter3"), enter},
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name: "does not submit an invalid password",
+			setup: func(m *Model) {
+				m.Validate = ValidateFunc(validate.NewValidation().Strength(3, "password is too weak"))
+			},
+			inputs:      []tea.KeyMsg{testutil.Runes("hunter2"), enter},
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name: "submits a valid password",
+			setup: func(m *Model) {
+				m.Validate = ValidateFunc(validate.NewValidation().Strength(3, "password is too weak"))
+			},
+			inputs:      []tea.KeyMsg{testutil.Runes("Hunter2!"), enter},
+			want:        "Hunter2!",
+			wantOutcome: outcome.Submitted,
+		},
+		{
+			name:        "cancelled via esc",
+			inputs:      []tea.KeyMsg{testutil.Runes("hunter2"), testutil.Key(tea.KeyEsc)},
+			wantOutcome: outcome.Cancelled,
+			wantErr:     outcome.ErrCancelled,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			if tt.setup != nil {
+				tt.setup(&m)
+			}
+			m.Init()
+			for _, msg := range tt.inputs {
+				m.Update(msg)
+			}
+
+			assert.Equal(t, tt.want, m.Value())
+			assert.Equal(t, tt.wantOutcome, m.Outcome())
+			assert.ErrorIs(t, m.Err(), tt.wantErr)
+			if tt.wantNotInView != "" {
+				assert.NotContains(t, stripansi.String(m.View()), tt.wantNotInView)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package pathpicker
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jimschubert/answer/input"
+	"github.com/jimschubert/answer/internal/paths"
+	"github.com/jimschubert/answer/outcome"
+	"github.com/jimschubert/answer/suggest"
+	"github.com/jimschubert/answer/validate"
+)
+
+var (
+	_ tea.Model = (*Model)(nil)
+)
+
+// ValidateFunc determines if the path is valid, returning nil if valid or an error if invalid
+type ValidateFunc validate.Func
+
+type KeyMap struct {
+	Complete     key.Binding
+	ToggleHidden key.Binding
+}
+
+var DefaultKeyMap = KeyMap{
+	Complete: key.NewBinding(
+		key.WithKeys(tea.KeyTab.String()),
+		key.WithHelp("tab", "complete"),
+	),
+	ToggleHidden: key.NewBinding(
+		key.WithKeys(tea.KeyCtrlT.String()),
+		key.WithHelp("ctrl+t", "show/hide hidden"),
+	),
+}
+
+// Model represents the bubble tea model for a file or directory path, suggesting paths from the local filesystem.
+// A leading ~ refers to the user's home directory.
+type Model struct {
+	PromptPrefix string
+	Prompt       string
+	Placeholder  string
+	// ShowHidden includes hidden entries (beginning with a dot) in the suggestions, and is toggled via KeyMap.ToggleHidden
+	ShowHidden bool
+	// DirsOnly limits suggestions to directories
+	DirsOnly bool
+	// Extensions limits suggested files to those having one of the extensions (e.g. ".yaml")
+	Extensions []string
+	// Validate is run against the path as typed, see validate.Func's FileExists, IsDir, IsWritable and Extension
+	Validate    ValidateFunc
+	Styles      input.Styles
+	KeyMap      KeyMap
+	input       input.Model
+	initialized bool
+}
+
+// New creates a new model with default settings.
+func New() Model {
+	defaults := input.New()
+	return Model{
+		PromptPrefix: defaults.PromptPrefix,
+		Styles:       defaults.Styles,
+		KeyMap:       DefaultKeyMap,
+		input:        defaults,
+	}
+}
+
+func (m *Model) setup() {
+	if m.Prompt == "" {
+		m.Prompt = "Please enter a path:"
+	}
+	m.input.PromptPrefix = m.PromptPrefix
+	m.input.Prompt = m.Prompt
+	m.input.Placeholder = m.Placeholder
+	m.input.Styles = m.Styles
+	if m.Validate != nil {
+		m.input.Validate = input.ValidateFunc(m.Validate)
+	}
+	m.input.Suggest = m.completion()
+	// partial paths are typically invalid, e.g. via FileExists, yet still need to be completed
+	m.input.SuggestOnError = true
+	m.input.Init()
+	m.initialized = true
+}
+
+// completion creates the filesystem completion according to the current options
+func (m *Model) completion() suggest.Completion {
+	options := []suggest.FilesystemOpt{suggest.FilesystemHidden(m.ShowHidden)}
+	if m.DirsOnly {
+		options = append(options, suggest.FilesystemDirsOnly())
+	}
+	if len(m.Extensions) > 0 {
+		options = append(options, suggest.FilesystemExtensions(m.Extensions...))
+	}
+	return suggest.Filesystem(options...)
+}
+
+func (m *Model) Init() tea.Cmd {
+	m.setup()
+	return nil
+}
+
+func (m *Model) SetValue(value string) {
+	m.input.SetValue(value)
+}
+
+// Resolve sets the value as though entered by the user, returning the error from Validate, if any. This allows the
+// path to be answered without user interaction.
+func (m *Model) Resolve(value string) error {
+	m.SetValue(value)
+	if m.Validate != nil {
+		return m.Validate(value)
+	}
+	return nil
+}
+
+// Value returns the path as typed
+func (m *Model) Value() string {
+	return m.input.Value()
+}
+
+// Path returns the cleaned path, with a leading ~ expanded to the user's home directory
+func (m *Model) Path() string {
+	value := m.input.Value()
+	if value == "" {
+		return ""
+	}
+	return filepath.Clean(paths.Expand(value))
+}
+
+// Reopen allows a submitted model to be edited again, retaining its current value and re-running validation
+func (m *Model) Reopen() {
+	m.input.Reopen()
+}
+
+// Outcome indicates whether the user has submitted or cancelled the path
+func (m *Model) Outcome() outcome.Outcome {
+	return m.input.Outcome()
+}
+
+// Err returns outcome.ErrCancelled if the user cancelled the path
+func (m *Model) Err() error {
+	return m.input.Err()
+}
+
+// complete extends the path by the prefix common to all suggestions, as a shell would
+func (m *Model) complete() tea.Cmd {
+	value := m.input.Value()
+	if value == "" {
+		return nil
+	}
+	prefix := suggest.CommonPrefix(m.input.Suggest(value))
+	if len(prefix) <= len(value) || !strings.HasPrefix(prefix, value) {
+		return nil
+	}
+
+	// type the remainder at the end of the path, so that validation and suggestions are updated as usual
+	_, _ = m.input.Update(tea.KeyMsg{Type: tea.KeyEnd})
+	_, cmd := m.input.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(prefix[len(value):])})
+	return cmd
+}
+
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if !m.initialized {
+		m.setup()
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok && m.input.Outcome() == outcome.Pending {
+		switch {
+		case key.Matches(msg, m.KeyMap.Complete):
+			return m, m.complete()
+		case key.Matches(msg, m.KeyMap.ToggleHidden):
+			m.ShowHidden = !m.ShowHidden
+			m.input.Suggest = m.completion()
+			return m, m.input.RefreshSuggestions()
+		}
+	}
+
+	_, cmd := m.input.Update(msg)
+	return m, cmd
+}
+
+func (m *Model) View() string {
+	return m.input.View()
+}
@@ -0,0 +1,184 @@
+package pathpicker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jimschubert/answer/internal/testutil"
+	"github.com/jimschubert/answer/outcome"
+	"github.com/jimschubert/answer/validate"
+	"github.com/stretchr/testify/assert"
+)
+
+// fixture creates a directory of files, returning the directory with a trailing separator
+func fixture(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	for _, name := range []string{"alpha.yaml", "alpha.json", ".hidden", filepath.Join("alps", "peak.txt")} {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir + string(filepath.Separator)
+}
+
+// refresh applies the suggestions for the current value, which are otherwise updated asynchronously
+func refresh(m *Model) {
+	if cmd := m.input.RefreshSuggestions(); cmd != nil {
+		m.Update(cmd())
+	}
+}
+
+// TestModel_View suggests entries of testdata/fixture rather than fixture, so rendered paths are the same on each run
+func TestModel_View(t *testing.T) {
+	tests := []struct {
+		name   string
+		setup  func(m *Model)
+		states []testutil.State
+	}{
+		{
+			name: "pathpicker",
+			states: []testutil.State{
+				{Name: "suggests entries of the directory", Inputs: []tea.Msg{testutil.Runes("testdata/fixture/")}, WaitFor: "alps/"},
+				{Name: "suggests hidden entries", Inputs: []tea.Msg{testutil.Runes("testdata/fixture/"), testutil.Key(tea.KeyCtrlT)}, WaitFor: ".hidden"},
+				{Name: "completes the common prefix", Inputs: []tea.Msg{testutil.Runes("testdata/fixture/a"), testutil.Key(tea.KeyTab)}, WaitFor: "alps/"},
+				{Name: "displays the path on submit", Inputs: []tea.Msg{testutil.Runes("testdata/fixture/alpha.yaml"), testutil.Key(tea.KeyEnter)}},
+			},
+		},
+		{
+			name: "pathpicker for directories",
+			setup: func(m *Model) {
+				m.DirsOnly = true
+			},
+			states: []testutil.State{
+				{Name: "suggests directories only", Inputs: []tea.Msg{testutil.Runes("testdata/fixture/")}, WaitFor: "alps/"},
+			},
+		},
+		{
+			name: "validatable pathpicker",
+			setup: func(m *Model) {
+				m.Validate = ValidateFunc(validate.NewValidation().FileExists("choose an existing file"))
+			},
+			states: []testutil.State{
+				{Name: "suggests while the path is invalid", Inputs: []tea.Msg{testutil.Runes("testdata/fixture/alp")}, WaitFor: "alps/"},
+				{Name: "displays validation message", Inputs: []tea.Msg{testutil.Runes("testdata/fixture/alps"), testutil.Key(tea.KeyEnter)}, WaitFor: "alps/"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		for _, s := range tt.states {
+			t.Run(tt.name+"_"+s.Name, func(t *testing.T) {
+				m := New()
+				if tt.setup != nil {
+					tt.setup(&m)
+				}
+				testutil.RequireGoldenView(t, &m, s)
+			})
+		}
+	}
+}
+
+func TestModel_Update(t *testing.T) {
+	dir := fixture(t)
+	sep := string(filepath.Separator)
+	tab := testutil.Key(tea.KeyTab)
+	tests := []struct {
+		name        string
+		setup       func(m *Model)
+		inputs      []tea.KeyMsg
+		want        string
+		wantOutcome outcome.Outcome
+	}{
+		{
+			name:        "suggests entries of the directory",
+			inputs:      []tea.KeyMsg{testutil.Runes(dir)},
+			want:        dir,
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name:        "completes the common prefix",
+			inputs:      []tea.KeyMsg{testutil.Runes(dir + "a"), tab},
+			want:        dir + "alp",
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name:        "completes a single suggestion",
+			inputs:      []tea.KeyMsg{testutil.Runes(dir + "alpha.y"), tab, testutil.Key(tea.KeyEnter)},
+			want:        dir + "alpha.yaml",
+			wantOutcome: outcome.Submitted,
+		},
+		{
+			name:        "does not complete without a longer prefix",
+			inputs:      []tea.KeyMsg{testutil.Runes(dir + "alp"), tab},
+			want:        dir + "alp",
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name:        "toggles hidden entries",
+			inputs:      []tea.KeyMsg{testutil.Runes(dir), testutil.Key(tea.KeyCtrlT)},
+			want:        dir,
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name: "suggests directories only",
+			setup: func(m *Model) {
+				m.DirsOnly = true
+			},
+			inputs:      []tea.KeyMsg{testutil.Runes(dir + "al"), tab},
+			want:        dir + "alps" + sep,
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name: "suggests while the path is invalid",
+			setup: func(m *Model) {
+				m.Validate = ValidateFunc(validate.NewValidation().FileExists("choose an existing file"))
+			},
+			inputs:      []tea.KeyMsg{testutil.Runes(dir + "alp")},
+			want:        dir + "alp",
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name: "does not submit an invalid path",
+			setup: func(m *Model) {
+				m.Validate = ValidateFunc(validate.NewValidation().FileExists())
+			},
+			inputs:      []tea.KeyMsg{testutil.Runes(dir + "alps"), testutil.Key(tea.KeyEnter)},
+			want:        dir + "alps",
+			wantOutcome: outcome.Pending,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			if tt.setup != nil {
+				tt.setup(&m)
+			}
+			m.Init()
+			for _, msg := range tt.inputs {
+				m.Update(msg)
+			}
+			refresh(&m)
+
+			assert.Equal(t, tt.want, m.Value())
+			assert.Equal(t, tt.wantOutcome, m.Outcome())
+		})
+	}
+}
+
+func TestModel_Path(t *testing.T) {
+	dir := fixture(t)
+	t.Setenv("HOME", dir)
+	t.Setenv("USERPROFILE", dir)
+
+	m := New()
+	m.Init()
+	m.SetValue("~/alps/../alpha.yaml")
+	assert.Equal(t, "~/alps/../alpha.yaml", m.Value())
+	assert.Equal(t, filepath.Join(dir, "alpha.yaml"), m.Path())
+}
@@ -0,0 +1,328 @@
+package rank
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/paginator"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jimschubert/answer/colors"
+	"github.com/jimschubert/answer/outcome"
+)
+
+var (
+	_ tea.Model = (*Model)(nil)
+)
+
+// Styles holds relevant styles used for rendering
+// For an introduction to styling with Lip Gloss see:
+// https://github.com/charmbracelet/lipgloss
+type Styles struct {
+	PromptPrefix     lipgloss.Style
+	Prompt           lipgloss.Style
+	Text             lipgloss.Style
+	Rank             lipgloss.Style
+	ChooserIndicator lipgloss.Style
+	Grabbed          lipgloss.Style
+}
+
+// Model represents the bubble tea model for ranking choices
+type Model struct {
+	PromptPrefix     string
+	Prompt           string
+	ChooserIndicator rune
+	GrabbedIndicator rune
+	Styles           Styles
+	Choices          []string
+	KeyMap           KeyMap
+	HideHelp         bool
+	PerPage          int
+	cursor           int
+	grabbed          bool
+	order            []int
+	paginator        paginator.Model
+	help             help.Model
+	initialized      bool
+	outcome          outcome.Outcome
+}
+
+type KeyMap struct {
+	SelectionUp   key.Binding
+	SelectionDown key.Binding
+	PageNext      key.Binding
+	PagePrev      key.Binding
+	Grab          key.Binding
+	Quit          key.Binding
+	Help          key.Binding
+	Enter         key.Binding
+}
+
+// ShortHelp returns keybindings to be shown in the mini help view. It's part
+// of the key.Map interface.
+func (k KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Grab, k.Help, k.Quit}
+}
+
+// FullHelp returns keybindings for the expanded help view. It's part of the
+// key.Map interface.
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.SelectionUp, k.SelectionDown, k.Grab},
+		{k.PagePrev, k.PageNext},
+		{k.Help, k.Quit},
+	}
+}
+
+var DefaultKeyMap = KeyMap{
+	SelectionUp: key.NewBinding(
+		key.WithKeys("k", tea.KeyUp.String()),
+		key.WithHelp("↑/k", "up"),
+	),
+	SelectionDown: key.NewBinding(
+		key.WithKeys("j", tea.KeyDown.String()),
+		key.WithHelp("↓/j", "down"),
+	),
+	PagePrev: key.NewBinding(
+		key.WithKeys("h", tea.KeyLeft.String()),
+		key.WithHelp("←/h", "prev"),
+	),
+	PageNext: key.NewBinding(
+		key.WithKeys("l", tea.KeyRight.String()),
+		key.WithHelp("→/l", "next"),
+	),
+	Grab: key.NewBinding(
+		key.WithKeys(tea.KeySpace.String()),
+		key.WithHelp("space", "grab/drop"),
+	),
+	Quit: key.NewBinding(
+		key.WithKeys("q", tea.KeyEsc.String(), tea.KeyCtrlC.String()),
+		key.WithHelp("q", "quit"),
+	),
+	Enter: key.NewBinding(key.WithKeys(tea.KeyEnter.String())),
+	Help: key.NewBinding(
+		key.WithKeys("?"),
+		key.WithHelp("?", "help"),
+	),
+}
+
+// New creates a new model with default settings.
+func New() Model {
+	return Model{
+		PromptPrefix:     "? ",
+		KeyMap:           DefaultKeyMap,
+		ChooserIndicator: '➤',
+		GrabbedIndicator: '↕',
+		Styles: Styles{
+			PromptPrefix:     lipgloss.NewStyle().Foreground(lipgloss.Color(colors.PromptPrefix)),
+			Rank:             lipgloss.NewStyle().Foreground(lipgloss.Color(colors.Placeholder)),
+			ChooserIndicator: lipgloss.NewStyle().Foreground(lipgloss.Color(colors.PromptPrefix)),
+			Grabbed:          lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(colors.PromptPrefix)),
+		},
+		help: help.New(),
+	}
+}
+
+func (m *Model) setup() {
+	if m.Prompt == "" {
+		m.Prompt = "Please rank:"
+	}
+
+	paginate := paginator.New()
+	paginate.Type = paginator.Dots
+	if m.PerPage < 1 {
+		paginate.PerPage = 10
+	} else {
+		paginate.PerPage = m.PerPage
+	}
+	paginate.ActiveDot = lipgloss.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "235", Dark: "252"}).Render("•")
+	paginate.InactiveDot = lipgloss.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "250", Dark: "238"}).Render("•")
+	paginate.SetTotalPages(len(m.Choices))
+	m.paginator = paginate
+
+	if len(m.order) != len(m.Choices) {
+		m.order = make([]int, 0, len(m.Choices))
+		for i := range m.Choices {
+			m.order = append(m.order, i)
+		}
+	}
+
+	m.initialized = true
+}
+
+// moveTo moves the cursor to position, changing the page as necessary. When an item is grabbed, the item moves with the
+// cursor, shifting the items between.
+func (m *Model) moveTo(position int) {
+	if position < 0 {
+		position = 0
+	}
+	if position > len(m.order)-1 {
+		position = len(m.order) - 1
+	}
+	if position < 0 || position == m.cursor {
+		return
+	}
+
+	if m.grabbed {
+		item := m.order[m.cursor]
+		if position < m.cursor {
+			copy(m.order[position+1:m.cursor+1], m.order[position:m.cursor])
+		} else {
+			copy(m.order[m.cursor:position], m.order[m.cursor+1:position+1])
+		}
+		m.order[position] = item
+	}
+	m.cursor = position
+	m.paginator.Page = position / m.paginator.PerPage
+}
+
+func (m *Model) Init() tea.Cmd {
+	m.setup()
+	return nil
+}
+
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if !m.initialized {
+		m.setup()
+	}
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		// If we set a width on the help menu it can gracefully truncate its view as needed.
+		m.help.Width = msg.Width
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.KeyMap.Quit):
+			m.outcome = outcome.Cancelled
+			return m, tea.Quit
+		case key.Matches(msg, m.KeyMap.Enter):
+			m.grabbed = false
+			m.outcome = outcome.Submitted
+			return m, tea.Quit
+		case key.Matches(msg, m.KeyMap.Grab):
+			m.grabbed = !m.grabbed && len(m.order) > 0
+		case key.Matches(msg, m.KeyMap.SelectionUp):
+			m.moveTo(m.cursor - 1)
+		case key.Matches(msg, m.KeyMap.SelectionDown):
+			m.moveTo(m.cursor + 1)
+		case key.Matches(msg, m.KeyMap.PagePrev):
+			m.moveTo(m.cursor - m.paginator.PerPage)
+		case key.Matches(msg, m.KeyMap.PageNext):
+			m.moveTo(m.cursor + m.paginator.PerPage)
+		case key.Matches(msg, m.KeyMap.Help):
+			m.help.ShowAll = !m.help.ShowAll
+		}
+	}
+
+	return m, nil
+}
+
+// Reopen allows a submitted model to be edited again, retaining its current order
+func (m *Model) Reopen() {
+	m.outcome = outcome.Pending
+}
+
+// Outcome indicates whether the user has submitted or cancelled the ranking
+func (m *Model) Outcome() outcome.Outcome {
+	return m.outcome
+}
+
+// Err returns outcome.ErrCancelled if the user cancelled the ranking
+func (m *Model) Err() error {
+	return m.outcome.Err()
+}
+
+// SetOrder ranks the choices in the order of values. An error is returned, leaving the order unmodified, unless values
+// contains each of the choices exactly once.
+func (m *Model) SetOrder(values ...string) error {
+	if !m.initialized {
+		m.setup()
+	}
+	if len(values) != len(m.Choices) {
+		return fmt.Errorf("expected %d ranked choices, got %d", len(m.Choices), len(values))
+	}
+
+	order := make([]int, 0, len(values))
+	used := make(map[int]bool, len(values))
+	for _, value := range values {
+		found := -1
+		for i, choice := range m.Choices {
+			if choice == value && !used[i] {
+				found = i
+				break
+			}
+		}
+		if found < 0 {
+			return fmt.Errorf("invalid choice %q", value)
+		}
+		used[found] = true
+		order = append(order, found)
+	}
+	m.order = order
+	return nil
+}
+
+// Indexes returns the original index of each choice, in ranked order
+func (m *Model) Indexes() []int {
+	indexes := make([]int, len(m.order))
+	copy(indexes, m.order)
+	return indexes
+}
+
+// Values returns the choices in ranked order
+func (m *Model) Values() []string {
+	values := make([]string, 0, len(m.order))
+	for _, idx := range m.order {
+		values = append(values, m.Choices[idx])
+	}
+	return values
+}
+
+func (m *Model) View() string {
+	styleText := m.Styles.Text.Inline(true).Render
+
+	var b strings.Builder
+	b.WriteString(m.Styles.PromptPrefix.Inline(true).Render(m.PromptPrefix))
+	if !strings.HasSuffix(m.PromptPrefix, " ") {
+		b.WriteString(" ")
+	}
+	b.WriteString(m.Styles.Prompt.Render(m.Prompt))
+	b.WriteString("\n\n")
+
+	width := len(fmt.Sprint(len(m.order)))
+	start, end := m.paginator.GetSliceBounds(len(m.order))
+	for i, idx := range m.order[start:end] {
+		position := start + i
+		cursor := " "
+		if m.cursor == position && m.outcome == outcome.Pending {
+			if m.grabbed {
+				cursor = m.Styles.ChooserIndicator.Inline(true).Render(string(m.GrabbedIndicator))
+			} else {
+				cursor = m.Styles.ChooserIndicator.Inline(true).Render(string(m.ChooserIndicator))
+			}
+		}
+
+		b.WriteString(cursor)
+		b.WriteString(" ")
+		b.WriteString(m.Styles.Rank.Inline(true).Render(fmt.Sprintf("%*d.", width, position+1)))
+		b.WriteString(" ")
+		if m.grabbed && m.cursor == position {
+			b.WriteString(m.Styles.Grabbed.Inline(true).Render(m.Choices[idx]))
+		} else {
+			b.WriteString(styleText(m.Choices[idx]))
+		}
+		b.WriteString("\n")
+	}
+	if m.paginator.TotalPages > 1 {
+		b.WriteString("  " + m.paginator.View())
+	}
+	if !m.HideHelp && m.outcome == outcome.Pending {
+		helpView := m.help.View(m.KeyMap)
+		b.WriteString("\n\n")
+		b.WriteString(helpView)
+	}
+	b.WriteString("\n")
+	return b.String()
+}
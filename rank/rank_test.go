@@ -0,0 +1,161 @@
+package rank
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jimschubert/answer/internal/testutil"
+	"github.com/jimschubert/answer/outcome"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestModel() Model {
+	m := New()
+	m.Prompt = "Rank these features:"
+	m.Choices = []string{"Dark mode", "Export", "Sharing", "Offline", "Plugins"}
+	return m
+}
+
+func TestModel_View(t *testing.T) {
+	space := testutil.Key(tea.KeySpace)
+	down := testutil.Key(tea.KeyDown)
+	right := testutil.Key(tea.KeyRight)
+	tests := []struct {
+		name    string
+		perPage int
+		states  []testutil.State
+	}{
+		{
+			name: "rank",
+			states: []testutil.State{
+				{Name: "displays choices and help"},
+				{Name: "moves the cursor", Inputs: []tea.Msg{down, down}},
+				{Name: "marks grabbed items", Inputs: []tea.Msg{space, down, down}},
+				{Name: "displays dropped items", Inputs: []tea.Msg{space, down, space, down}},
+				{Name: "displays ranking on submit", Inputs: []tea.Msg{space, down, testutil.Key(tea.KeyEnter)}},
+				{Name: "clears on cancel", Inputs: []tea.Msg{testutil.Key(tea.KeyEsc)}},
+			},
+		},
+		{
+			name:    "paginated rank",
+			perPage: 2,
+			states: []testutil.State{
+				{Name: "displays first page"},
+				{Name: "moves grabbed items across pages", Inputs: []tea.Msg{down, space, down, down}},
+				{Name: "moves grabbed items by page", Inputs: []tea.Msg{space, right, right}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		for _, s := range tt.states {
+			t.Run(tt.name+"_"+s.Name, func(t *testing.T) {
+				m := newTestModel()
+				m.PerPage = tt.perPage
+				testutil.RequireGoldenView(t, &m, s)
+			})
+		}
+	}
+}
+
+func TestModel_Update(t *testing.T) {
+	space := testutil.Key(tea.KeySpace)
+	up := testutil.Key(tea.KeyUp)
+	down := testutil.Key(tea.KeyDown)
+	left := testutil.Key(tea.KeyLeft)
+	right := testutil.Key(tea.KeyRight)
+	choices := []string{"Dark mode", "Export", "Sharing", "Offline", "Plugins"}
+	tests := []struct {
+		name        string
+		perPage     int
+		inputs      []tea.KeyMsg
+		want        []string
+		wantIndexes []int
+		wantOutcome outcome.Outcome
+	}{
+		{
+			name:        "retains order without grabbing",
+			inputs:      []tea.KeyMsg{down, down, up},
+			want:        []string{"Dark mode", "Export", "Sharing", "Offline", "Plugins"},
+			wantIndexes: []int{0, 1, 2, 3, 4},
+		},
+		{
+			name:        "moves grabbed items down",
+			inputs:      []tea.KeyMsg{space, down, down},
+			want:        []string{"Export", "Sharing", "Dark mode", "Offline", "Plugins"},
+			wantIndexes: []int{1, 2, 0, 3, 4},
+		},
+		{
+			name:        "moves grabbed items up",
+			inputs:      []tea.KeyMsg{down, down, down, down, space, up, up, up, up, up, space},
+			want:        []string{"Plugins", "Dark mode", "Export", "Sharing", "Offline"},
+			wantIndexes: []int{4, 0, 1, 2, 3},
+		},
+		{
+			name:        "drops grabbed items",
+			inputs:      []tea.KeyMsg{space, down, space, down},
+			want:        []string{"Export", "Dark mode", "Sharing", "Offline", "Plugins"},
+			wantIndexes: []int{1, 0, 2, 3, 4},
+		},
+		{
+			name:        "moves grabbed items across pages",
+			perPage:     2,
+			inputs:      []tea.KeyMsg{down, space, down, down},
+			want:        []string{"Dark mode", "Sharing", "Offline", "Export", "Plugins"},
+			wantIndexes: []int{0, 2, 3, 1, 4},
+		},
+		{
+			name:        "moves grabbed items by page",
+			perPage:     2,
+			inputs:      []tea.KeyMsg{space, right, right, left},
+			want:        []string{"Export", "Sharing", "Dark mode", "Offline", "Plugins"},
+			wantIndexes: []int{1, 2, 0, 3, 4},
+		},
+		{
+			name:        "submits via enter",
+			inputs:      []tea.KeyMsg{space, down, testutil.Key(tea.KeyEnter)},
+			want:        []string{"Export", "Dark mode", "Sharing", "Offline", "Plugins"},
+			wantIndexes: []int{1, 0, 2, 3, 4},
+			wantOutcome: outcome.Submitted,
+		},
+		{
+			name:        "cancels via esc",
+			inputs:      []tea.KeyMsg{testutil.Key(tea.KeyEsc)},
+			want:        []string{"Dark mode", "Export", "Sharing", "Offline", "Plugins"},
+			wantIndexes: []int{0, 1, 2, 3, 4},
+			wantOutcome: outcome.Cancelled,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.Prompt = "Rank these features:"
+			m.Choices = choices
+			m.PerPage = tt.perPage
+			m.Init()
+			for _, msg := range tt.inputs {
+				m.Update(msg)
+			}
+			assert.Equal(t, tt.want, m.Values())
+			assert.Equal(t, tt.wantIndexes, m.Indexes())
+			assert.Equal(t, tt.wantOutcome, m.Outcome())
+			assert.Equal(t, []string{"Dark mode", "Export", "Sharing", "Offline", "Plugins"}, choices)
+		})
+	}
+}
+
+func TestModel_SetOrder(t *testing.T) {
+	m := New()
+	m.Choices = []string{"a", "b", "c"}
+
+	assert.NoError(t, m.SetOrder("c", "a", "b"))
+	assert.Equal(t, []string{"c", "a", "b"}, m.Values())
+	assert.Equal(t, []int{2, 0, 1}, m.Indexes())
+
+	assert.EqualError(t, m.SetOrder("a", "b"), "expected 3 ranked choices, got 2")
+	assert.EqualError(t, m.SetOrder("a", "b", "b"), `invalid choice "b"`)
+	assert.EqualError(t, m.SetOrder("a", "b", "d"), `invalid choice "d"`)
+	assert.Equal(t, []string{"c", "a", "b"}, m.Values())
+
+	m.Init()
+	assert.Equal(t, []string{"c", "a", "b"}, m.Values())
+}
@@ -0,0 +1,41 @@
+package selection
+
+import "github.com/jimschubert/answer/internal/render"
+
+// Choice is a structured item displayed by its Label, while representing an underlying Value of any type
+type Choice struct {
+	// Label is displayed to the user, and is the text matched when filtering
+	Label string
+	// Value is the underlying value of the choice. When nil, the Label is the value.
+	Value any
+	// Description is an optional line displayed beneath the Label
+	Description string
+	// Disabled prevents the choice from being selected, and is skipped when navigating
+	Disabled bool
+	// DisabledReason optionally explains to the user why the choice is disabled
+	DisabledReason string
+}
+
+// String returns the text form of Value, as returned by Model.SelectedValues
+func (c Choice) String() string {
+	return render.Value(c.Value, c.Label)
+}
+
+// choicesOf converts plain strings into choices where each string is both the label and value
+func choicesOf(values []string) []Choice {
+	choices := make([]Choice, 0, len(values))
+	for _, value := range values {
+		choices = append(choices, Choice{Label: value})
+	}
+	return choices
+}
+
+// Group is a named section of choices, displayed beneath a non-selectable header
+type Group struct {
+	// Name is displayed as the header of the group
+	Name string
+	// Items are the choices within the group
+	Items []Choice
+	// MaxSelections limits the number of selections within the group, where 0 is unlimited
+	MaxSelections int
+}
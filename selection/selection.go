@@ -1,21 +1,29 @@
 package selection
 
 import (
+	"fmt"
 	"sort"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/paginator"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/jimschubert/answer/colors"
+	"github.com/jimschubert/answer/internal/render"
+	"github.com/jimschubert/answer/outcome"
+	"github.com/jimschubert/answer/suggest"
 )
 
 var (
 	_ tea.Model = (*Model)(nil)
 )
 
+// ValidateFunc determines if the selections are valid, returning nil if valid or an error if invalid
+type ValidateFunc func(indexes []int, values []string) error
+
 // Styles holds relevant styles used for rendering
 // For an introduction to styling with Lip Gloss see:
 // https://github.com/charmbracelet/lipgloss
@@ -25,6 +33,12 @@ type Styles struct {
 	Text              lipgloss.Style
 	SelectedIndicator lipgloss.Style
 	ChooserIndicator  lipgloss.Style
+	ErrorPrefix       lipgloss.Style
+	ErrorText         lipgloss.Style
+	MatchHighlight    lipgloss.Style
+	Description       lipgloss.Style
+	Disabled          lipgloss.Style
+	GroupHeader       lipgloss.Style
 }
 
 // Model represents the bubble tea model for the selection
@@ -35,16 +49,36 @@ type Model struct {
 	ChooserIndicator  rune
 	Styles            Styles
 	Choices           []string
-	KeyMap            KeyMap
-	MaxSelections     int
-	HideHelp          bool
-	PerPage           int
-	cursor            int
-	paginator         paginator.Model
-	help              help.Model
-	initialized       bool
-	selected          map[int]struct{}
-	all               bool
+	// Items are structured choices supporting labels, values of any type, descriptions and disabled states.
+	// When set, Items are displayed rather than Choices.
+	Items []Choice
+	// Groups are sections of choices, each displayed beneath a header. When set, Groups are displayed rather than
+	// Items or Choices.
+	Groups        []Group
+	KeyMap        KeyMap
+	MaxSelections int
+	MinSelections int
+	Validate      ValidateFunc
+	HideHelp      bool
+	PerPage       int
+	// Filter creates the matcher used to narrow the visible choices as the user types, e.g. suggest.StartsWith or
+	// suggest.LevenshteinDistance. When nil, choices are filtered via suggest.Fuzzy, and the runes of the visible choices
+	// matching the filter text are styled by Styles.MatchHighlight.
+	Filter      func(choices []string) suggest.Completion
+	cursor      int
+	paginator   paginator.Model
+	help        help.Model
+	initialized bool
+	selected    map[int]struct{}
+	outcome     outcome.Outcome
+	err         error
+	filter      textinput.Model
+	filtering   bool
+	complete    suggest.Completion
+	positions   map[string][]int
+	visible     []int
+	items       []Choice
+	groupOf     []int
 }
 
 type KeyMap struct {
@@ -57,6 +91,10 @@ type KeyMap struct {
 	Help          key.Binding
 	Enter         key.Binding
 	ToggleAll     key.Binding
+	ToggleGroup   key.Binding
+	Filter        key.Binding
+	AcceptFilter  key.Binding
+	CancelFilter  key.Binding
 }
 
 // ShortHelp returns keybindings to be shown in the mini help view. It's part
@@ -70,8 +108,8 @@ func (k KeyMap) ShortHelp() []key.Binding {
 func (k KeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{k.SelectionUp, k.SelectionDown, k.Select},
-		{k.PagePrev, k.PageNext, k.ToggleAll},
-		{k.Help, k.Quit},
+		{k.PagePrev, k.PageNext, k.ToggleAll, k.ToggleGroup},
+		{k.Filter, k.Help, k.Quit},
 	}
 }
 
@@ -109,6 +147,26 @@ var DefaultKeyMap = KeyMap{
 		key.WithKeys(tea.KeyTab.String()),
 		key.WithHelp("tab", "all/none"),
 	),
+	ToggleGroup: key.NewBinding(
+		key.WithKeys(tea.KeyShiftTab.String()),
+		key.WithHelp("shift+tab", "group all/none"),
+	),
+	Filter: key.NewBinding(
+		key.WithKeys("/"),
+		key.WithHelp("/", "filter"),
+	),
+	AcceptFilter: key.NewBinding(
+		key.WithKeys(tea.KeyEnter.String()),
+		key.WithHelp("enter", "apply filter"),
+	),
+	CancelFilter: key.NewBinding(
+		key.WithKeys(tea.KeyEsc.String()),
+		key.WithHelp("esc", "clear filter"),
+	),
+}
+
+func defaultFilter(choices []string) suggest.Completion {
+	return suggest.Fuzzy(choices)
 }
 
 // New creates a new model with default settings.
@@ -122,6 +180,12 @@ func New() Model {
 			PromptPrefix:      lipgloss.NewStyle().Foreground(lipgloss.Color(colors.PromptPrefix)),
 			SelectedIndicator: lipgloss.NewStyle().Foreground(lipgloss.Color(colors.PromptPrefix)),
 			ChooserIndicator:  lipgloss.NewStyle().Foreground(lipgloss.Color(colors.PromptPrefix)),
+			ErrorPrefix:       lipgloss.NewStyle().Foreground(lipgloss.Color(colors.ErrorPrefix)),
+			ErrorText:         lipgloss.NewStyle().Foreground(lipgloss.Color(colors.Placeholder)),
+			MatchHighlight:    lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(colors.MatchHighlight)),
+			Description:       lipgloss.NewStyle().Foreground(lipgloss.Color(colors.Placeholder)),
+			Disabled:          lipgloss.NewStyle().Foreground(lipgloss.Color(colors.Placeholder)),
+			GroupHeader:       lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color(colors.PromptPrefix)),
 		},
 		help:     help.New(),
 		selected: make(map[int]struct{}),
@@ -144,18 +208,173 @@ func (m *Model) setup() {
 	paginate.InactiveDot = lipgloss.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "250", Dark: "238"}).Render("•")
 	paginate.KeyMap.NextPage = m.KeyMap.PageNext
 	paginate.KeyMap.PrevPage = m.KeyMap.PagePrev
-	paginate.SetTotalPages(len(m.Choices))
-
 	m.paginator = paginate
+
+	m.items, m.groupOf = nil, nil
+	switch {
+	case len(m.Groups) > 0:
+		for g, group := range m.Groups {
+			for _, item := range group.Items {
+				m.items = append(m.items, item)
+				m.groupOf = append(m.groupOf, g)
+			}
+		}
+	case len(m.Items) > 0:
+		m.items = m.Items
+	default:
+		m.items = choicesOf(m.Choices)
+	}
+	m.KeyMap.ToggleGroup.SetEnabled(len(m.Groups) > 0)
+	labels := make([]string, 0, len(m.items))
+	m.positions = make(map[string][]int, len(m.items))
+	for i, item := range m.items {
+		labels = append(labels, item.Label)
+		m.positions[item.Label] = append(m.positions[item.Label], i)
+	}
+	if m.Filter != nil {
+		m.complete = m.Filter(labels)
+	} else {
+		m.complete = defaultFilter(labels)
+	}
+
+	filter := textinput.New()
+	filter.Prompt = "/ "
+	filter.PromptStyle = m.Styles.PromptPrefix
+	filter.TextStyle = m.Styles.Text
+	m.filter = filter
+	m.applyFilter()
+
 	m.initialized = true
 }
 
+// match locates the runes of label matching query for highlighting. Only the default filter (suggest.Fuzzy) matches by
+// position, so choices narrowed by a custom Filter aren't highlighted.
+func (m *Model) match(query, label string) (suggest.Match, bool) {
+	if m.Filter != nil {
+		return suggest.Match{}, false
+	}
+	return suggest.FuzzyMatch(query, label)
+}
+
+// applyFilter determines the visible choices (as indexes into items) from the current filter text
+func (m *Model) applyFilter() {
+	m.visible = m.visible[:0]
+	if query := m.filter.Value(); query == "" {
+		for i := range m.items {
+			m.visible = append(m.visible, i)
+		}
+	} else {
+		seen := make(map[int]struct{})
+		for _, match := range m.complete(query) {
+			for _, idx := range m.positions[match] {
+				if _, ok := seen[idx]; !ok {
+					seen[idx] = struct{}{}
+					m.visible = append(m.visible, idx)
+				}
+			}
+		}
+		if len(m.Groups) > 0 {
+			// matches remain beneath their group headers, so are kept in their original order
+			sort.Ints(m.visible)
+		}
+	}
+
+	m.paginator.Page = 0
+	if len(m.visible) == 0 {
+		m.paginator.TotalPages = 1
+	} else {
+		m.paginator.SetTotalPages(len(m.visible))
+	}
+	m.firstEnabled()
+}
+
+// current returns the index into items of the item under the cursor, or -1 when no items are visible
+func (m *Model) current() int {
+	start, end := m.paginator.GetSliceBounds(len(m.visible))
+	if start+m.cursor >= end {
+		return -1
+	}
+	return m.visible[start+m.cursor]
+}
+
+// cursorUp moves the cursor to the previous enabled item on the current page
+func (m *Model) cursorUp() {
+	start, _ := m.paginator.GetSliceBounds(len(m.visible))
+	for i := m.cursor - 1; i >= 0; i-- {
+		if !m.items[m.visible[start+i]].Disabled {
+			m.cursor = i
+			return
+		}
+	}
+}
+
+// cursorDown moves the cursor to the next enabled item on the current page
+func (m *Model) cursorDown() {
+	start, end := m.paginator.GetSliceBounds(len(m.visible))
+	// since results are paged, the maximum to iterate is the max number on the current page
+	for i := m.cursor + 1; i < end-start; i++ {
+		if !m.items[m.visible[start+i]].Disabled {
+			m.cursor = i
+			return
+		}
+	}
+}
+
+// firstEnabled moves the cursor to the first enabled item on the current page
+func (m *Model) firstEnabled() {
+	m.cursor = 0
+	if idx := m.current(); idx >= 0 && m.items[idx].Disabled {
+		m.cursorDown()
+	}
+}
+
+// updateFilter handles key presses while the user is typing a filter
+func (m *Model) updateFilter(msg tea.KeyMsg) tea.Cmd {
+	switch {
+	case key.Matches(msg, m.KeyMap.CancelFilter):
+		m.filtering = false
+		m.filter.Blur()
+		m.filter.SetValue("")
+		m.applyFilter()
+		return nil
+	case key.Matches(msg, m.KeyMap.AcceptFilter):
+		m.filtering = false
+		m.filter.Blur()
+		return nil
+	// runes are typed into the filter, so only other keys (e.g. ctrl+c or arrows) quit or move the cursor
+	case msg.Type != tea.KeyRunes && key.Matches(msg, m.KeyMap.Quit):
+		m.outcome = outcome.Cancelled
+		return tea.Quit
+	case msg.Type != tea.KeyRunes && key.Matches(msg, m.KeyMap.SelectionUp):
+		m.cursorUp()
+		return nil
+	case msg.Type != tea.KeyRunes && key.Matches(msg, m.KeyMap.SelectionDown):
+		m.cursorDown()
+		return nil
+	}
+
+	var cmd tea.Cmd
+	before := m.filter.Value()
+	m.filter, cmd = m.filter.Update(msg)
+	if m.filter.Value() != before {
+		m.applyFilter()
+	}
+	return cmd
+}
+
 func (m *Model) Init() tea.Cmd {
 	m.setup()
 	return nil
 }
 
 func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if !m.initialized {
+		m.setup()
+	}
+	if msg, ok := msg.(tea.KeyMsg); ok && m.filtering {
+		return m, m.updateFilter(msg)
+	}
+
 	var cmd tea.Cmd
 	m.paginator, cmd = m.paginator.Update(msg)
 
@@ -165,45 +384,66 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.help.Width = msg.Width
 	case tea.KeyMsg:
 		switch {
-		case key.Matches(msg, m.KeyMap.Quit, m.KeyMap.Enter):
-			m.HideHelp = true
+		case key.Matches(msg, m.KeyMap.Quit):
+			m.outcome = outcome.Cancelled
 			return m, tea.Quit
-		case key.Matches(msg, m.KeyMap.SelectionUp):
-			if m.cursor > 0 {
-				m.cursor--
+		case key.Matches(msg, m.KeyMap.Enter):
+			if m.err = m.checkMinimum(len(m.selected)); m.err != nil {
+				return m, cmd
 			}
-		case key.Matches(msg, m.KeyMap.SelectionDown):
-			start, end := m.paginator.GetSliceBounds(len(m.Choices))
-			// since results are paged, the maximum to iterate is the max number on the current page
-			maximum := end - start
-			if m.cursor < maximum-1 && maximum > 0 {
-				m.cursor++
+			if m.err = m.validate(); m.err != nil {
+				return m, cmd
 			}
+			m.outcome = outcome.Submitted
+			return m, tea.Quit
+		case key.Matches(msg, m.KeyMap.SelectionUp):
+			m.cursorUp()
+		case key.Matches(msg, m.KeyMap.SelectionDown):
+			m.cursorDown()
 		case key.Matches(msg, m.KeyMap.PageNext, m.KeyMap.PagePrev):
-			m.cursor = 0
+			m.firstEnabled()
 		case key.Matches(msg, m.KeyMap.Help):
 			m.help.ShowAll = !m.help.ShowAll
+		case key.Matches(msg, m.KeyMap.Filter):
+			m.filtering = true
+			return m, tea.Batch(cmd, m.filter.Focus())
 		case key.Matches(msg, m.KeyMap.Select):
-			start, _ := m.paginator.GetSliceBounds(len(m.Choices))
-			idx := start + m.cursor
-			if _, ok := m.selected[idx]; ok {
-				delete(m.selected, idx)
-			} else {
-				m.selected[idx] = struct{}{}
+			idx := m.current()
+			if idx < 0 || m.items[idx].Disabled {
+				break
 			}
 			if m.MaxSelections == 1 {
+				// single-select replaces any prior selection, e.g. when the model has been reopened
+				if m.err = m.checkGroupMaximum(nil, idx); m.err != nil {
+					break
+				}
+				m.selected = map[int]struct{}{idx: {}}
+				if m.err = m.validate(); m.err != nil {
+					break
+				}
+				m.outcome = outcome.Submitted
 				return m, tea.Quit
 			}
+			if _, ok := m.selected[idx]; ok {
+				delete(m.selected, idx)
+				m.err = m.validate()
+			} else if m.err = m.checkMaximum(len(m.selected) + 1); m.err != nil {
+				break
+			} else if m.err = m.checkGroupMaximum(m.selected, idx); m.err == nil {
+				m.selected[idx] = struct{}{}
+				m.err = m.validate()
+			}
 		case key.Matches(msg, m.KeyMap.ToggleAll):
-			m.all = !m.all
-			if m.all {
-				for i, _ := range m.Choices {
-					m.selected[i] = struct{}{}
-				}
-			} else {
-				for i, _ := range m.Choices {
-					delete(m.selected, i)
+			m.toggle(m.visible)
+		case key.Matches(msg, m.KeyMap.ToggleGroup):
+			if idx := m.current(); idx >= 0 && len(m.groupOf) > 0 {
+				group := make([]int, 0)
+				for _, i := range m.visible {
+					if m.groupOf[i] == m.groupOf[idx] {
+						group = append(group, i)
+					}
 				}
+				m.toggle(group)
 			}
 		}
 	}
@@ -211,6 +451,142 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
+// toggle selects all enabled choices of indexes, or deselects them if all are already selected
+func (m *Model) toggle(indexes []int) {
+	unselected := make([]int, 0)
+	for _, idx := range indexes {
+		if _, ok := m.selected[idx]; !ok && !m.items[idx].Disabled {
+			unselected = append(unselected, idx)
+		}
+	}
+
+	if len(unselected) == 0 {
+		for _, idx := range indexes {
+			delete(m.selected, idx)
+		}
+	} else {
+		if m.err = m.checkMaximum(len(m.selected) + len(unselected)); m.err != nil {
+			return
+		}
+		if m.err = m.checkGroupMaximum(m.selected, unselected...); m.err != nil {
+			return
+		}
+		for _, idx := range unselected {
+			m.selected[idx] = struct{}{}
+		}
+	}
+	m.err = m.validate()
+}
+
+// checkMaximum determines whether count selections are allowed by MaxSelections
+func (m *Model) checkMaximum(count int) error {
+	if m.MaxSelections > 0 && count > m.MaxSelections {
+		return fmt.Errorf("maximum selections allowed=%d", m.MaxSelections)
+	}
+	return nil
+}
+
+// checkGroupMaximum determines whether adding indexes to selected is allowed by the MaxSelections of each group
+func (m *Model) checkGroupMaximum(selected map[int]struct{}, adding ...int) error {
+	if len(m.Groups) == 0 {
+		return nil
+	}
+	counts := make([]int, len(m.Groups))
+	for idx := range selected {
+		counts[m.groupOf[idx]]++
+	}
+	for _, idx := range adding {
+		counts[m.groupOf[idx]]++
+	}
+	for g, group := range m.Groups {
+		if group.MaxSelections > 0 && counts[g] > group.MaxSelections {
+			return fmt.Errorf("maximum selections allowed for %s=%d", group.Name, group.MaxSelections)
+		}
+	}
+	return nil
+}
+
+// checkMinimum determines whether count selections satisfy MinSelections
+func (m *Model) checkMinimum(count int) error {
+	if count < m.MinSelections {
+		return fmt.Errorf("minimum selections required=%d actual=%d", m.MinSelections, count)
+	}
+	return nil
+}
+
+// validate runs the Validate function, if defined, against the current selections
+func (m *Model) validate() error {
+	if m.Validate == nil {
+		return nil
+	}
+	return m.Validate(m.SelectedIndexes(), m.SelectedValues())
+}
+
+// Reopen allows a submitted model to be edited again, retaining its current page, cursor and selections and re-running validation
+func (m *Model) Reopen() {
+	m.outcome = outcome.Pending
+	m.err = m.validate()
+}
+
+// Outcome indicates whether the user has submitted or cancelled the selection
+func (m *Model) Outcome() outcome.Outcome {
+	return m.outcome
+}
+
+// Err returns outcome.ErrCancelled if the user cancelled the selection
+func (m *Model) Err() error {
+	return m.outcome.Err()
+}
+
+// SelectValues replaces any current selections with the choices matching values, by either value or label.
+// An error is returned, leaving selections unmodified, if any value is not an enabled choice, the number of values
+// does not satisfy MinSelections and MaxSelections, or the selections are rejected by Validate.
+func (m *Model) SelectValues(values ...string) error {
+	if !m.initialized {
+		m.setup()
+	}
+	if err := m.checkMaximum(len(values)); err != nil {
+		return err
+	}
+	if err := m.checkMinimum(len(values)); err != nil {
+		return err
+	}
+
+	selected := make(map[int]struct{}, len(values))
+	missing := make([]string, 0)
+	for _, value := range values {
+		found := -1
+		for i, item := range m.items {
+			if item.String() == value || item.Label == value {
+				found = i
+				break
+			}
+		}
+		switch {
+		case found < 0:
+			missing = append(missing, fmt.Sprintf("%q", value))
+		case m.items[found].Disabled:
+			return fmt.Errorf("choice %q is disabled", value)
+		default:
+			selected[found] = struct{}{}
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("invalid choice %s", strings.Join(missing, ", "))
+	}
+	if err := m.checkGroupMaximum(selected); err != nil {
+		return err
+	}
+
+	previous := m.selected
+	m.selected = selected
+	if err := m.validate(); err != nil {
+		m.selected = previous
+		return err
+	}
+	return nil
+}
+
 func (m *Model) SelectedIndexes() []int {
 	indexes := make([]int, 0)
 	for idx := range m.selected {
@@ -220,19 +596,50 @@ func (m *Model) SelectedIndexes() []int {
 	return indexes
 }
 
+// SelectedValues returns the text of each selected choice's value. See Choice.String.
 func (m *Model) SelectedValues() []string {
 	values := make([]string, 0)
-	for i, choice := range m.Choices {
+	for i, item := range m.items {
 		if _, ok := m.selected[i]; ok {
-			values = append(values, choice)
+			values = append(values, item.String())
 		}
 	}
 	return values
 }
 
+// SelectedGroups returns the selected items of each group, omitting groups without selections
+func (m *Model) SelectedGroups() []Group {
+	groups := make([]Group, 0)
+	last := -1
+	for i, item := range m.items {
+		if _, ok := m.selected[i]; !ok || len(m.groupOf) == 0 {
+			continue
+		}
+		if m.groupOf[i] != last {
+			last = m.groupOf[i]
+			group := m.Groups[last]
+			groups = append(groups, Group{Name: group.Name, MaxSelections: group.MaxSelections})
+		}
+		groups[len(groups)-1].Items = append(groups[len(groups)-1].Items, item)
+	}
+	return groups
+}
+
+// SelectedChoices returns the selected items
+func (m *Model) SelectedChoices() []Choice {
+	choices := make([]Choice, 0)
+	for i, item := range m.items {
+		if _, ok := m.selected[i]; ok {
+			choices = append(choices, item)
+		}
+	}
+	return choices
+}
+
 func (m *Model) View() string {
 
 	styleText := m.Styles.Text.Inline(true).Render
+	query := m.filter.Value()
 
 	var b strings.Builder
 	b.WriteString(m.Styles.PromptPrefix.Inline(true).Render(m.PromptPrefix))
@@ -240,11 +647,25 @@ func (m *Model) View() string {
 		b.WriteString(" ")
 	}
 	b.WriteString(m.Styles.Prompt.Render(m.Prompt))
+	if m.filtering || query != "" {
+		b.WriteString("\n")
+		b.WriteString(m.filter.View())
+	}
 	b.WriteString("\n\n")
 
-	start, end := m.paginator.GetSliceBounds(len(m.Choices))
-	for i, item := range m.Choices[start:end] {
-		idx := start + i
+	start, end := m.paginator.GetSliceBounds(len(m.visible))
+	if start == end && len(m.items) > 0 {
+		b.WriteString("  ")
+		b.WriteString(m.Styles.ErrorText.Inline(true).Render("no matches"))
+		b.WriteString("\n")
+	}
+	for i, idx := range m.visible[start:end] {
+		item := m.items[idx]
+		// headers are repeated at the top of each page, so the group of each item remains visible
+		if len(m.groupOf) > 0 && (i == 0 || m.groupOf[m.visible[start+i-1]] != m.groupOf[idx]) {
+			b.WriteString(m.Styles.GroupHeader.Inline(true).Render(m.Groups[m.groupOf[idx]].Name))
+			b.WriteString("\n")
+		}
 		cursor := " "
 		if m.cursor == i {
 			cursor = m.Styles.ChooserIndicator.Inline(true).Render(string(m.ChooserIndicator))
@@ -252,7 +673,9 @@ func (m *Model) View() string {
 
 		b.WriteString(cursor)
 		b.WriteString(" ")
+		indent := "  "
 		if m.MaxSelections != 1 {
+			indent += "    "
 			b.WriteString(styleText("["))
 			if _, ok := m.selected[idx]; ok {
 				b.WriteString(styleText(m.Styles.SelectedIndicator.Inline(true).Render(string(m.SelectedIndicator))))
@@ -261,13 +684,34 @@ func (m *Model) View() string {
 			}
 			b.WriteString(styleText("] "))
 		}
-		b.WriteString(styleText(item))
+		if item.Disabled {
+			label := item.Label
+			if item.DisabledReason != "" {
+				label += " (" + item.DisabledReason + ")"
+			}
+			b.WriteString(m.Styles.Disabled.Inline(true).Render(label))
+		} else if match, ok := m.match(query, item.Label); ok {
+			b.WriteString(render.Highlight(item.Label, match.Positions, m.Styles.Text.Inline(true), m.Styles.MatchHighlight.Inline(true)))
+		} else {
+			b.WriteString(styleText(item.Label))
+		}
 		b.WriteString("\n")
+		if item.Description != "" {
+			b.WriteString(indent)
+			b.WriteString(m.Styles.Description.Inline(true).Render(item.Description))
+			b.WriteString("\n")
+		}
 	}
 	if m.paginator.TotalPages > 1 {
 		b.WriteString("  " + m.paginator.View())
 	}
-	if !m.HideHelp {
+	if m.err != nil && m.outcome == outcome.Pending {
+		if m.paginator.TotalPages > 1 {
+			b.WriteString("\n")
+		}
+		render.WriteError(&b, m.err, m.Styles.ErrorPrefix, m.Styles.ErrorText)
+	}
+	if !m.HideHelp && m.outcome == outcome.Pending {
 		helpView := m.help.View(m.KeyMap)
 		b.WriteString("\n\n")
 		b.WriteString(helpView)
@@ -1,14 +1,20 @@
 package selection
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"testing"
 	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/x/exp/teatest"
+	"github.com/jimschubert/answer/outcome"
+	"github.com/jimschubert/answer/suggest"
 	"github.com/jimschubert/stripansi"
+	"github.com/muesli/termenv"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -142,6 +148,597 @@ func TestModel_View(t *testing.T) {
 	}
 }
 
+func TestModel_Outcome(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxSelections int
+		inputs        []tea.KeyMsg
+		want          outcome.Outcome
+		wantErr       error
+	}{
+		{name: "pending", inputs: []tea.KeyMsg{{Type: tea.KeyDown}}, want: outcome.Pending},
+		{name: "submitted via enter", inputs: []tea.KeyMsg{{Type: tea.KeySpace}, {Type: tea.KeyEnter}}, want: outcome.Submitted},
+		{name: "submitted via single select", maxSelections: 1, inputs: []tea.KeyMsg{{Type: tea.KeySpace}}, want: outcome.Submitted},
+		{name: "cancelled via esc", inputs: []tea.KeyMsg{{Type: tea.KeySpace}, {Type: tea.KeyEsc}}, want: outcome.Cancelled, wantErr: outcome.ErrCancelled},
+		{name: "cancelled via q", inputs: []tea.KeyMsg{{Type: tea.KeyRunes, Runes: []rune{'q'}}}, want: outcome.Cancelled, wantErr: outcome.ErrCancelled},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.Choices = []string{"Red", "Green", "Blue"}
+			m.MaxSelections = tt.maxSelections
+			m.Init()
+			for _, msg := range tt.inputs {
+				m.Update(msg)
+			}
+			assert.Equal(t, tt.want, m.Outcome())
+			assert.ErrorIs(t, m.Err(), tt.wantErr)
+		})
+	}
+}
+
+func TestModel_SelectionLimits(t *testing.T) {
+	space := tea.KeyMsg{Type: tea.KeySpace}
+	down := tea.KeyMsg{Type: tea.KeyDown}
+	tests := []struct {
+		name          string
+		minSelections int
+		maxSelections int
+		inputs        []tea.KeyMsg
+		want          []int
+		wantOutcome   outcome.Outcome
+		wantView      string
+	}{
+		{
+			name:          "refuses selections beyond maximum",
+			maxSelections: 2,
+			inputs:        []tea.KeyMsg{space, down, space, down, space},
+			want:          []int{0, 1},
+			wantOutcome:   outcome.Pending,
+			wantView:      "✘ maximum selections allowed=2",
+		},
+		{
+			name:          "allows selection after deselecting at maximum",
+			maxSelections: 2,
+			inputs:        []tea.KeyMsg{space, down, space, down, space, {Type: tea.KeyUp}, space, down, space},
+			want:          []int{0, 2},
+			wantOutcome:   outcome.Pending,
+		},
+		{
+			name:          "refuses toggle all beyond maximum",
+			maxSelections: 2,
+			inputs:        []tea.KeyMsg{{Type: tea.KeyTab}},
+			want:          []int{},
+			wantOutcome:   outcome.Pending,
+			wantView:      "✘ maximum selections allowed=2",
+		},
+		{
+			name:          "allows toggle all within maximum",
+			maxSelections: 3,
+			inputs:        []tea.KeyMsg{{Type: tea.KeyTab}, {Type: tea.KeyEnter}},
+			want:          []int{0, 1, 2},
+			wantOutcome:   outcome.Submitted,
+		},
+		{
+			name:          "refuses submission below minimum",
+			minSelections: 2,
+			inputs:        []tea.KeyMsg{space, {Type: tea.KeyEnter}},
+			want:          []int{0},
+			wantOutcome:   outcome.Pending,
+			wantView:      "✘ minimum selections required=2 actual=1",
+		},
+		{
+			name:          "allows submission at minimum",
+			minSelections: 2,
+			inputs:        []tea.KeyMsg{space, {Type: tea.KeyEnter}, down, space, {Type: tea.KeyEnter}},
+			want:          []int{0, 1},
+			wantOutcome:   outcome.Submitted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.Choices = []string{"Red", "Green", "Blue"}
+			m.MinSelections = tt.minSelections
+			m.MaxSelections = tt.maxSelections
+			m.Init()
+			for _, msg := range tt.inputs {
+				m.Update(msg)
+			}
+			assert.Equal(t, tt.want, m.SelectedIndexes())
+			assert.Equal(t, tt.wantOutcome, m.Outcome())
+			if tt.wantView != "" {
+				assert.Contains(t, stripansi.String(m.View()), tt.wantView)
+			} else {
+				assert.NotContains(t, stripansi.String(m.View()), "✘")
+			}
+		})
+	}
+}
+
+func TestModel_Validate(t *testing.T) {
+	space := tea.KeyMsg{Type: tea.KeySpace}
+	down := tea.KeyMsg{Type: tea.KeyDown}
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	validate := func(indexes []int, values []string) error {
+		var databases, caches int
+		for _, idx := range indexes {
+			if idx < 2 {
+				databases++
+			} else {
+				caches++
+			}
+		}
+		var errs []error
+		if databases != 1 {
+			errs = append(errs, errors.New("choose a database"))
+		}
+		if caches > 1 {
+			errs = append(errs, errors.New("choose at most one cache"))
+		}
+		return errors.Join(errs...)
+	}
+	tests := []struct {
+		name          string
+		maxSelections int
+		inputs        []tea.KeyMsg
+		want          []int
+		wantOutcome   outcome.Outcome
+		wantView      string
+	}{
+		{
+			name:        "validates on toggle",
+			inputs:      []tea.KeyMsg{down, down, space},
+			want:        []int{2},
+			wantOutcome: outcome.Pending,
+			wantView:    "✘ choose a database\n",
+		},
+		{
+			name:        "renders joined errors",
+			inputs:      []tea.KeyMsg{down, down, space, down, space},
+			want:        []int{2, 3},
+			wantOutcome: outcome.Pending,
+			wantView:    "✘ choose a database\n✘ choose at most one cache\n",
+		},
+		{
+			name:        "prevents submission while invalid",
+			inputs:      []tea.KeyMsg{enter},
+			want:        []int{},
+			wantOutcome: outcome.Pending,
+			wantView:    "✘ choose a database\n",
+		},
+		{
+			name:        "allows submission once valid",
+			inputs:      []tea.KeyMsg{down, down, space, {Type: tea.KeyUp}, {Type: tea.KeyUp}, space, enter},
+			want:        []int{0, 2},
+			wantOutcome: outcome.Submitted,
+		},
+		{
+			name:          "prevents single-select submission while invalid",
+			maxSelections: 1,
+			inputs:        []tea.KeyMsg{down, down, space},
+			want:          []int{2},
+			wantOutcome:   outcome.Pending,
+			wantView:      "✘ choose a database\n",
+		},
+		{
+			name:          "allows single-select submission once valid",
+			maxSelections: 1,
+			inputs:        []tea.KeyMsg{down, down, space, {Type: tea.KeyUp}, {Type: tea.KeyUp}, space},
+			want:          []int{0},
+			wantOutcome:   outcome.Submitted,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.Choices = []string{"Postgres", "MySQL", "Redis", "Memcached"}
+			m.Validate = validate
+			if tt.maxSelections > 0 {
+				m.MaxSelections = tt.maxSelections
+			}
+			m.Init()
+			for _, msg := range tt.inputs {
+				m.Update(msg)
+			}
+			assert.Equal(t, tt.want, m.SelectedIndexes())
+			assert.Equal(t, tt.wantOutcome, m.Outcome())
+			if tt.wantView != "" {
+				assert.Contains(t, stripansi.String(m.View()), tt.wantView)
+			}
+		})
+	}
+
+	t.Run("validates selected values", func(t *testing.T) {
+		m := New()
+		m.Choices = []string{"Postgres", "MySQL", "Redis", "Memcached"}
+		m.Validate = validate
+		m.Init()
+		assert.EqualError(t, m.SelectValues("Redis"), "choose a database")
+		assert.Equal(t, []int{}, m.SelectedIndexes())
+		assert.NoError(t, m.SelectValues("MySQL", "Redis"))
+		assert.Equal(t, []int{1, 2}, m.SelectedIndexes())
+	})
+}
+
+func TestModel_Filter(t *testing.T) {
+	slash := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}}
+	space := tea.KeyMsg{Type: tea.KeySpace}
+	esc := tea.KeyMsg{Type: tea.KeyEsc}
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	typed := func(value string) tea.KeyMsg {
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(value)}
+	}
+	regions := []string{"us-east-1", "us-east-2", "us-west-1", "eu-west-1", "eu-central-1", "ap-south-1"}
+	tests := []struct {
+		name        string
+		filter      func(choices []string) suggest.Completion
+		inputs      []tea.KeyMsg
+		want        []int
+		wantOutcome outcome.Outcome
+		wantView    []string
+		notInView   []string
+	}{
+		{
+			name:      "narrows visible choices",
+			inputs:    []tea.KeyMsg{slash, typed("eu")},
+			want:      []int{},
+			wantView:  []string{"/ eu", "eu-west-1", "eu-central-1"},
+			notInView: []string{"us-east-1", "ap-south-1"},
+		},
+		{
+			name:   "selects by original index while filtered",
+			inputs: []tea.KeyMsg{slash, typed("eu"), enter, {Type: tea.KeyDown}, space},
+			want:   []int{4},
+			wantView: []string{
+				"[x] eu-central-1",
+			},
+			wantOutcome: outcome.Pending,
+		},
+		{
+			name:        "preserves selections when filter is cleared",
+			inputs:      []tea.KeyMsg{space, slash, typed("eu"), {Type: tea.KeyEnter}, space, slash, esc, enter},
+			want:        []int{0, 3},
+			wantOutcome: outcome.Submitted,
+		},
+		{
+			name:      "matches fuzzily",
+			inputs:    []tea.KeyMsg{slash, typed("ec")},
+			want:      []int{},
+			wantView:  []string{"eu-central-1"},
+			notInView: []string{"eu-west-1", "us-east-1"},
+		},
+		{
+			name:        "does not quit when typing quit keys",
+			inputs:      []tea.KeyMsg{slash, typed("q")},
+			want:        []int{},
+			wantOutcome: outcome.Pending,
+			wantView:    []string{"no matches"},
+		},
+		{
+			name:        "cancels via ctrl+c while filtering",
+			inputs:      []tea.KeyMsg{slash, {Type: tea.KeyCtrlC}},
+			want:        []int{},
+			wantOutcome: outcome.Cancelled,
+		},
+		{
+			name:        "toggles all visible choices",
+			inputs:      []tea.KeyMsg{slash, typed("us-east"), enter, {Type: tea.KeyTab}, esc},
+			want:        []int{0, 1},
+			wantOutcome: outcome.Cancelled,
+		},
+		{
+			name: "supports pluggable matchers",
+			filter: func(choices []string) suggest.Completion {
+				return suggest.LevenshteinDistance(choices, suggest.LevenshteinDistanceMax(1))
+			},
+			inputs:    []tea.KeyMsg{slash, typed("us-east-3")},
+			want:      []int{},
+			wantView:  []string{"us-east-1", "us-east-2"},
+			notInView: []string{"us-west-1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.Choices = regions
+			m.Filter = tt.filter
+			m.Init()
+			for _, msg := range tt.inputs {
+				m.Update(msg)
+			}
+			assert.Equal(t, tt.want, m.SelectedIndexes())
+			assert.Equal(t, tt.wantOutcome, m.Outcome())
+			view := stripansi.String(m.View())
+			for _, expected := range tt.wantView {
+				assert.Contains(t, view, expected)
+			}
+			for _, unexpected := range tt.notInView {
+				assert.NotContains(t, view, unexpected)
+			}
+		})
+	}
+}
+
+func TestModel_Items(t *testing.T) {
+	space := tea.KeyMsg{Type: tea.KeySpace}
+	down := tea.KeyMsg{Type: tea.KeyDown}
+	up := tea.KeyMsg{Type: tea.KeyUp}
+	tab := tea.KeyMsg{Type: tea.KeyTab}
+	type zone struct{ id int }
+	items := []Choice{
+		{Label: "us-east-1 — N. Virginia", Value: "us-east-1", Description: "Lowest latency for most customers"},
+		{Label: "us-west-1 — N. California", Value: "us-west-1", Disabled: true, DisabledReason: "capacity exhausted"},
+		{Label: "eu-west-1 — Ireland", Value: zone{id: 3}},
+		{Label: "ap-south-1"},
+	}
+	tests := []struct {
+		name        string
+		inputs      []tea.KeyMsg
+		selectValue []string
+		want        []int
+		wantValues  []string
+		wantErr     string
+		wantView    []string
+	}{
+		{
+			name:       "returns values rather than labels",
+			inputs:     []tea.KeyMsg{space, down, space},
+			want:       []int{0, 2},
+			wantValues: []string{"us-east-1", "{3}"},
+		},
+		{
+			name:       "defaults values to labels",
+			inputs:     []tea.KeyMsg{down, down, space},
+			want:       []int{3},
+			wantValues: []string{"ap-south-1"},
+		},
+		{
+			name:       "skips disabled choices when navigating",
+			inputs:     []tea.KeyMsg{down, down, up, space},
+			want:       []int{2},
+			wantValues: []string{"{3}"},
+		},
+		{
+			name:       "does not select disabled choices when toggling all",
+			inputs:     []tea.KeyMsg{tab},
+			want:       []int{0, 2, 3},
+			wantValues: []string{"us-east-1", "{3}", "ap-south-1"},
+		},
+		{
+			name:        "selects by value or label",
+			selectValue: []string{"us-east-1", "ap-south-1", "eu-west-1 — Ireland"},
+			want:        []int{0, 2, 3},
+			wantValues:  []string{"us-east-1", "{3}", "ap-south-1"},
+		},
+		{
+			name:        "refuses to select disabled choices",
+			selectValue: []string{"us-west-1"},
+			want:        []int{},
+			wantValues:  []string{},
+			wantErr:     `choice "us-west-1" is disabled`,
+		},
+		{
+			name:       "renders descriptions and disabled reasons",
+			want:       []int{},
+			wantValues: []string{},
+			wantView: []string{
+				"➤ [ ] us-east-1 — N. Virginia\n      Lowest latency for most customers\n",
+				"  [ ] us-west-1 — N. California (capacity exhausted)\n",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.Items = items
+			m.Init()
+			for _, msg := range tt.inputs {
+				m.Update(msg)
+			}
+			if tt.selectValue != nil {
+				err := m.SelectValues(tt.selectValue...)
+				if tt.wantErr != "" {
+					assert.EqualError(t, err, tt.wantErr)
+				} else {
+					assert.NoError(t, err)
+				}
+			}
+			assert.Equal(t, tt.want, m.SelectedIndexes())
+			assert.Equal(t, tt.wantValues, m.SelectedValues())
+			for i, choice := range m.SelectedChoices() {
+				assert.Equal(t, items[tt.want[i]], choice)
+			}
+			view := stripansi.String(m.View())
+			for _, expected := range tt.wantView {
+				assert.Contains(t, view, expected)
+			}
+		})
+	}
+}
+
+func TestModel_Groups(t *testing.T) {
+	space := tea.KeyMsg{Type: tea.KeySpace}
+	down := tea.KeyMsg{Type: tea.KeyDown}
+	right := tea.KeyMsg{Type: tea.KeyRight}
+	shiftTab := tea.KeyMsg{Type: tea.KeyShiftTab}
+	groups := []Group{
+		{Name: "Databases", Items: []Choice{{Label: "Postgres"}, {Label: "MySQL"}}, MaxSelections: 1},
+		{Name: "Queues", Items: []Choice{{Label: "Kafka"}, {Label: "RabbitMQ"}}},
+		{Name: "Caches", Items: []Choice{{Label: "Redis"}, {Label: "Memcached"}}},
+	}
+	tests := []struct {
+		name        string
+		inputs      []tea.KeyMsg
+		selectValue []string
+		want        []string
+		wantGroups  []Group
+		wantErr     string
+		wantView    []string
+		notInView   []string
+	}{
+		{
+			name:   "returns flat and grouped selections",
+			inputs: []tea.KeyMsg{space, down, down, space},
+			want:   []string{"Postgres", "Kafka"},
+			wantGroups: []Group{
+				{Name: "Databases", Items: []Choice{{Label: "Postgres"}}, MaxSelections: 1},
+				{Name: "Queues", Items: []Choice{{Label: "Kafka"}}},
+			},
+		},
+		{
+			name:       "enforces group maximum",
+			inputs:     []tea.KeyMsg{space, down, space},
+			want:       []string{"Postgres"},
+			wantGroups: []Group{{Name: "Databases", Items: []Choice{{Label: "Postgres"}}, MaxSelections: 1}},
+			wantView:   []string{"✘ maximum selections allowed for Databases=1"},
+		},
+		{
+			name:       "toggles all choices of a group",
+			inputs:     []tea.KeyMsg{down, down, shiftTab},
+			want:       []string{"Kafka", "RabbitMQ"},
+			wantGroups: []Group{{Name: "Queues", Items: []Choice{{Label: "Kafka"}, {Label: "RabbitMQ"}}}},
+		},
+		{
+			name:       "enforces group maximum when toggling a group",
+			inputs:     []tea.KeyMsg{shiftTab},
+			want:       []string{},
+			wantGroups: []Group{},
+			wantView:   []string{"✘ maximum selections allowed for Databases=1"},
+		},
+		{
+			name:        "enforces group maximum when selecting values",
+			selectValue: []string{"Postgres", "MySQL"},
+			want:        []string{},
+			wantGroups:  []Group{},
+			wantErr:     "maximum selections allowed for Databases=1",
+		},
+		{
+			name:       "renders headers at group changes",
+			want:       []string{},
+			wantGroups: []Group{},
+			wantView:   []string{"Databases\n➤ [ ] Postgres\n  [ ] MySQL\nQueues\n  [ ] Kafka\n"},
+			notInView:  []string{"Caches"},
+		},
+		{
+			name:       "renders the header at the top of each page",
+			inputs:     []tea.KeyMsg{right},
+			want:       []string{},
+			wantGroups: []Group{},
+			wantView:   []string{"Queues\n➤ [ ] RabbitMQ\nCaches\n  [ ] Redis\n  [ ] Memcached\n"},
+			notInView:  []string{"Databases"},
+		},
+		{
+			name:       "renders headers of filtered choices",
+			inputs:     []tea.KeyMsg{{Type: tea.KeyRunes, Runes: []rune{'/'}}, {Type: tea.KeyRunes, Runes: []rune("re")}},
+			want:       []string{},
+			wantGroups: []Group{},
+			wantView:   []string{"Databases\n➤ [ ] Postgres\nCaches\n  [ ] Redis\n"},
+			notInView:  []string{"Queues"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.Groups = groups
+			m.PerPage = 3
+			m.Init()
+			for _, msg := range tt.inputs {
+				m.Update(msg)
+			}
+			if tt.selectValue != nil {
+				assert.EqualError(t, m.SelectValues(tt.selectValue...), tt.wantErr)
+			}
+			assert.Equal(t, tt.want, m.SelectedValues())
+			assert.Equal(t, tt.wantGroups, m.SelectedGroups())
+			view := stripansi.String(m.View())
+			for _, expected := range tt.wantView {
+				assert.Contains(t, view, expected)
+			}
+			for _, unexpected := range tt.notInView {
+				assert.NotContains(t, view, unexpected)
+			}
+		})
+	}
+}
+
+func TestModel_MatchHighlight(t *testing.T) {
+	renderer := lipgloss.NewRenderer(io.Discard)
+	renderer.SetColorProfile(termenv.ANSI)
+
+	m := New()
+	m.Choices = []string{"us-east-1", "eu-west-1", "eu-central-1"}
+	m.Styles.MatchHighlight = renderer.NewStyle().Bold(true)
+	m.Init()
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("ec")})
+
+	view := m.View()
+	assert.Contains(t, view, "\x1b[1me\x1b[0mu-\x1b[1mc\x1b[0mentral-1")
+	assert.NotContains(t, view, "us-east-1")
+}
+
+func TestModel_MatchHighlight_customFilter(t *testing.T) {
+	renderer := lipgloss.NewRenderer(io.Discard)
+	renderer.SetColorProfile(termenv.ANSI)
+
+	m := New()
+	m.Choices = []string{"us-east-1", "eu-west-1", "eu-central-1"}
+	m.Styles.MatchHighlight = renderer.NewStyle().Bold(true)
+	m.Filter = func(choices []string) suggest.Completion {
+		return suggest.LevenshteinDistance(choices, suggest.LevenshteinDistanceMax(2))
+	}
+	m.Init()
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("us-east-2")})
+
+	view := m.View()
+	assert.Contains(t, view, "us-east-1")
+	assert.NotContains(t, view, "\x1b[1m", "matches of other filters should not be highlighted")
+}
+
+func TestModel_filterKeyMap(t *testing.T) {
+	m := New()
+	m.Choices = []string{"kiwi", "kumquat", "lime"}
+	m.KeyMap.SelectionDown = key.NewBinding(key.WithKeys("j", tea.KeyCtrlN.String()))
+	m.KeyMap.SelectionUp = key.NewBinding(key.WithKeys("k", tea.KeyCtrlP.String()))
+	m.Init()
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'k'}})
+	assert.Equal(t, "k", m.filter.Value(), "runes should be typed into the filter")
+
+	m.Update(tea.KeyMsg{Type: tea.KeyCtrlN})
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m.Update(tea.KeyMsg{Type: tea.KeySpace})
+	assert.Equal(t, []string{"kumquat"}, m.SelectedValues())
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+	m.Update(tea.KeyMsg{Type: tea.KeyCtrlP})
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m.Update(tea.KeyMsg{Type: tea.KeySpace})
+	assert.Equal(t, []string{"kiwi", "kumquat"}, m.SelectedValues())
+}
+
+func TestModel_filterQuit(t *testing.T) {
+	m := New()
+	m.Choices = []string{"kiwi", "kumquat", "lime"}
+	m.KeyMap.Quit = key.NewBinding(key.WithKeys("q", tea.KeyCtrlQ.String()))
+	m.Init()
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}})
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+	assert.Nil(t, cmd, "unbound keys should not quit")
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+	assert.Equal(t, "q", m.filter.Value(), "runes should be typed into the filter")
+	assert.Equal(t, outcome.Pending, m.Outcome())
+
+	_, cmd = m.Update(tea.KeyMsg{Type: tea.KeyCtrlQ})
+	assert.Equal(t, outcome.Cancelled, m.Outcome())
+	if assert.NotNil(t, cmd) {
+		assert.Equal(t, tea.Quit(), cmd())
+	}
+}
+
 func readBts(tb testing.TB, r io.Reader) []byte {
 	tb.Helper()
 	bts, err := io.ReadAll(r)
@@ -0,0 +1,114 @@
+package suggest
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jimschubert/answer/internal/paths"
+)
+
+type fsOpts struct {
+	hidden     bool
+	dirsOnly   bool
+	extensions []string
+}
+
+// FilesystemOpt represents a function type that manipulates an internal options configuration.
+type FilesystemOpt func(o *fsOpts)
+
+// FilesystemHidden returns a FilesystemOpt function that sets whether hidden entries (beginning with a dot) are
+// suggested. Regardless of this option, hidden entries are suggested once the search begins with a dot.
+func FilesystemHidden(hidden bool) FilesystemOpt {
+	return func(o *fsOpts) {
+		o.hidden = hidden
+	}
+}
+
+// FilesystemDirsOnly returns a FilesystemOpt function that limits suggestions to directories.
+func FilesystemDirsOnly() FilesystemOpt {
+	return func(o *fsOpts) {
+		o.dirsOnly = true
+	}
+}
+
+// FilesystemExtensions returns a FilesystemOpt function that limits suggested files to those having one of the
+// extensions (e.g. ".yaml"), ignoring case. Directories are always suggested, so that they may be navigated.
+func FilesystemExtensions(extensions ...string) FilesystemOpt {
+	return func(o *fsOpts) {
+		o.extensions = append(o.extensions, extensions...)
+	}
+}
+
+// Filesystem returns a function (Completion) which suggests paths on the local filesystem.
+// The Completion function lists the directory of the search (relative to the working directory unless absolute),
+// returning the entries beginning with the final element of the search. A leading ~ refers to the user's home
+// directory, and is retained in the suggestions. Directories are suggested with a trailing separator.
+func Filesystem(options ...FilesystemOpt) Completion {
+	opts := fsOpts{}
+	for _, opt := range options {
+		opt(&opts)
+	}
+
+	return func(value string) []string {
+		results := make([]string, 0)
+		if value == "~" {
+			return append(results, value+string(filepath.Separator))
+		}
+
+		dir, prefix := "", value
+		if idx := strings.LastIndexAny(value, paths.Separators); idx >= 0 {
+			dir, prefix = value[:idx+1], value[idx+1:]
+		}
+		listing := paths.Expand(dir)
+		if listing == "" {
+			listing = "."
+		}
+
+		entries, err := os.ReadDir(listing)
+		if err != nil {
+			return results
+		}
+		for _, entry := range entries {
+			name := entry.Name()
+			if !strings.HasPrefix(name, prefix) {
+				continue
+			}
+			if strings.HasPrefix(name, ".") && !opts.hidden && !strings.HasPrefix(prefix, ".") {
+				continue
+			}
+
+			isDir := entry.IsDir()
+			if entry.Type()&os.ModeSymlink != 0 {
+				if info, err := os.Stat(filepath.Join(listing, name)); err == nil {
+					isDir = info.IsDir()
+				}
+			}
+			if isDir {
+				results = append(results, dir+name+string(filepath.Separator))
+				continue
+			}
+			if opts.dirsOnly || (len(opts.extensions) > 0 && !paths.HasExtension(name, opts.extensions...)) {
+				continue
+			}
+			results = append(results, dir+name)
+		}
+		return results
+	}
+}
+
+// CommonPrefix returns the longest prefix shared by all values, e.g. to complete as much of a search as possible
+func CommonPrefix(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	prefix := values[0]
+	for _, value := range values[1:] {
+		for !strings.HasPrefix(value, prefix) {
+			_, size := utf8.DecodeLastRuneInString(prefix)
+			prefix = prefix[:len(prefix)-size]
+		}
+	}
+	return prefix
+}
@@ -0,0 +1,122 @@
+package suggest
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// fixture creates a directory of files for completion, returning the directory with a trailing separator
+func fixture(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	for _, name := range []string{"alpha.yaml", "alpha.json", "Beta.YML", ".hidden", filepath.Join("alps", "peak.txt")} {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir + string(filepath.Separator)
+}
+
+func TestFilesystem(t *testing.T) {
+	dir := fixture(t)
+	sep := string(filepath.Separator)
+	tests := []struct {
+		name    string
+		options []FilesystemOpt
+		value   string
+		want    []string
+	}{
+		{
+			name:  "lists the directory",
+			value: dir,
+			want:  []string{dir + "Beta.YML", dir + "alpha.json", dir + "alpha.yaml", dir + "alps" + sep},
+		},
+		{
+			name:  "filters by the final element",
+			value: dir + "alp",
+			want:  []string{dir + "alpha.json", dir + "alpha.yaml", dir + "alps" + sep},
+		},
+		{
+			name:  "lists nested directories",
+			value: dir + "alps" + sep,
+			want:  []string{dir + "alps" + sep + "peak.txt"},
+		},
+		{
+			name:    "includes hidden entries when enabled",
+			options: []FilesystemOpt{FilesystemHidden(true)},
+			value:   dir,
+			want:    []string{dir + ".hidden", dir + "Beta.YML", dir + "alpha.json", dir + "alpha.yaml", dir + "alps" + sep},
+		},
+		{
+			name:  "includes hidden entries when searching for them",
+			value: dir + ".",
+			want:  []string{dir + ".hidden"},
+		},
+		{
+			name:    "limits to directories",
+			options: []FilesystemOpt{FilesystemDirsOnly()},
+			value:   dir,
+			want:    []string{dir + "alps" + sep},
+		},
+		{
+			name:    "limits files to extensions",
+			options: []FilesystemOpt{FilesystemExtensions(".yaml", "yml")},
+			value:   dir,
+			want:    []string{dir + "Beta.YML", dir + "alpha.yaml", dir + "alps" + sep},
+		},
+		{
+			name:  "empty when the directory does not exist",
+			value: dir + "missing" + sep,
+			want:  []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Filesystem(tt.options...)(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Filesystem() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilesystem_home(t *testing.T) {
+	dir := fixture(t)
+	t.Setenv("HOME", dir)
+	t.Setenv("USERPROFILE", dir)
+	sep := string(filepath.Separator)
+
+	complete := Filesystem()
+	if got, want := complete("~"), []string{"~" + sep}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Filesystem() = %v, want %v", got, want)
+	}
+	if got, want := complete("~"+sep+"alps"), []string{"~" + sep + "alps" + sep}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Filesystem() = %v, want %v", got, want)
+	}
+}
+
+func TestCommonPrefix(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   string
+	}{
+		{name: "empty", values: nil, want: ""},
+		{name: "single value", values: []string{"alpha.yaml"}, want: "alpha.yaml"},
+		{name: "shared prefix", values: []string{"alpha.yaml", "alpha.json", "alps/"}, want: "alp"},
+		{name: "multi-byte runes", values: []string{"café.txt", "cafè.txt"}, want: "caf"},
+		{name: "no shared prefix", values: []string{"alpha", "beta"}, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CommonPrefix(tt.values); got != tt.want {
+				t.Errorf("CommonPrefix() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,145 @@
+package suggest
+
+import (
+	"sort"
+	"unicode"
+)
+
+type fuzzyOpts struct {
+	ignoreCase bool
+	minLen     int
+}
+
+// FuzzyOpt represents a function type that manipulates an internal options configuration.
+type FuzzyOpt func(o *fuzzyOpts)
+
+// FuzzyIgnoreCase returns a FuzzyOpt function that sets whether matching ignores case. By default, case is ignored.
+func FuzzyIgnoreCase(ignoreCase bool) FuzzyOpt {
+	return func(o *fuzzyOpts) {
+		o.ignoreCase = ignoreCase
+	}
+}
+
+// FuzzyMin returns a FuzzyOpt function that modifies the minimum search length. By default, the minimum length is 1.
+func FuzzyMin(minimum int) FuzzyOpt {
+	return func(o *fuzzyOpts) {
+		if minimum >= 0 {
+			o.minLen = minimum
+		}
+	}
+}
+
+// Match describes how a value matched a fuzzy search
+type Match struct {
+	// Value is the matched value
+	Value string
+
+	// Score ranks the quality of the match, where higher is better.
+	// Consecutive runes and runes at the start of words score higher, while gaps between matched runes score lower.
+	Score int
+
+	// Positions holds the rune index within Value of each matched rune of the search
+	Positions []int
+}
+
+// FuzzyMatch determines whether every rune of search appears in value, in order, ignoring case.
+// When matched, the returned Match includes the position of each matched rune.
+func FuzzyMatch(search, value string) (Match, bool) {
+	return fuzzyMatch(search, value, true)
+}
+
+func fuzzyMatch(search, value string, ignoreCase bool) (Match, bool) {
+	searchRunes := stringToRunes(search, ignoreCase)
+	valueRunes := stringToRunes(value, ignoreCase)
+	if len(searchRunes) == 0 || len(searchRunes) > len(valueRunes) {
+		return Match{}, false
+	}
+
+	positions := make([]int, 0, len(searchRunes))
+	score := 0
+	last := -1
+	for _, r := range searchRunes {
+		found := -1
+		for j := last + 1; j < len(valueRunes); j++ {
+			if valueRunes[j] == r {
+				found = j
+				break
+			}
+		}
+		if found < 0 {
+			return Match{}, false
+		}
+
+		score++
+		if found == last+1 && last >= 0 {
+			// consecutive runes
+			score += 5
+		}
+		if found == 0 || !unicode.IsLetter(valueRunes[found-1]) && !unicode.IsDigit(valueRunes[found-1]) {
+			// start of a word
+			score += 3
+		}
+		if last >= 0 {
+			score -= minimum(found-last-1, 3)
+		} else {
+			score -= minimum(found, 3)
+		}
+
+		positions = append(positions, found)
+		last = found
+	}
+
+	return Match{Value: value, Score: score, Positions: positions}, true
+}
+
+// FuzzyMatches returns each value in data matching search as with FuzzyMatch, ordered by descending Score.
+// Values with equal scores retain their order from data.
+func FuzzyMatches(data []string, search string, options ...FuzzyOpt) []Match {
+	opts := fuzzyOpts{
+		ignoreCase: true,
+		minLen:     1,
+	}
+	for _, opt := range options {
+		opt(&opts)
+	}
+
+	matches := make([]Match, 0)
+	if len([]rune(search)) < opts.minLen {
+		return matches
+	}
+	for _, s := range data {
+		if match, ok := fuzzyMatch(search, s, opts.ignoreCase); ok {
+			matches = append(matches, match)
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+	return matches
+}
+
+// Fuzzy takes a slice of strings (data) and returns a function (Completion).
+// The Completion function takes a string (search query) and returns all strings from data containing each rune of the
+// search query in order, best matches first. See FuzzyMatches for details.
+func Fuzzy(data []string, options ...FuzzyOpt) Completion {
+	input := data[:]
+	// update/view are done via goroutines, so we need to synchronize shared data between threads
+	persistent := safeResults{results: make([]string, 0)}
+
+	return func(value string) []string {
+		persistent.mux.Lock()
+		defer persistent.mux.Unlock()
+
+		if persistent.current == value {
+			return persistent.results
+		}
+
+		// keep allocated slice memory
+		persistent.results = persistent.results[:0]
+		for _, match := range FuzzyMatches(input, value, options...) {
+			persistent.results = append(persistent.results, match.Value)
+		}
+		persistent.current = value
+		return persistent.results
+	}
+}
@@ -0,0 +1,122 @@
+package suggest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuzzyMatch(t *testing.T) {
+	tests := []struct {
+		name   string
+		search string
+		value  string
+		want   Match
+		wantOk bool
+	}{
+		{
+			name:   "matches runes at word boundaries",
+			search: "ec",
+			value:  "eu-central-1",
+			want:   Match{Value: "eu-central-1", Score: 6, Positions: []int{0, 3}},
+			wantOk: true,
+		},
+		{
+			name:   "matches consecutive runes ignoring case",
+			search: "EU",
+			value:  "eu-west-1",
+			want:   Match{Value: "eu-west-1", Score: 10, Positions: []int{0, 1}},
+			wantOk: true,
+		},
+		{
+			name:   "reports positions as rune indexes",
+			search: "é",
+			value:  "café",
+			want:   Match{Value: "café", Score: -2, Positions: []int{3}},
+			wantOk: true,
+		},
+		{
+			name:   "matches the earliest following occurrence of each rune",
+			search: "ue",
+			value:  "eu-west-1",
+			wantOk: true,
+			want:   Match{Value: "eu-west-1", Score: -1, Positions: []int{1, 4}},
+		},
+		{
+			name:   "does not match missing runes",
+			search: "xyz",
+			value:  "eu-west-1",
+		},
+		{
+			name:   "does not match empty search",
+			search: "",
+			value:  "eu-west-1",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := FuzzyMatch(tt.search, tt.value)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFuzzy(t *testing.T) {
+	sample := []string{"able", "ablest", "ablative", "abba", "about", "batter", "battering", "battery"}
+	type args struct {
+		data    []string
+		options []FuzzyOpt
+	}
+	tests := []struct {
+		name  string
+		args  args
+		value string
+		want  []string
+	}{
+		{
+			name:  "empty when passed empty string",
+			args:  args{data: sample},
+			value: "",
+			want:  []string{},
+		},
+		{
+			name:  "empty when no matches found",
+			args:  args{data: sample},
+			value: "car",
+			want:  []string{},
+		},
+		{
+			name:  "results ordered by best match",
+			args:  args{data: sample},
+			value: "bt",
+			want:  []string{"batter", "battering", "battery", "ablative", "about", "ablest"},
+		},
+		{
+			name:  "results ignore case by default",
+			args:  args{data: sample},
+			value: "BT",
+			want:  []string{"batter", "battering", "battery", "ablative", "about", "ablest"},
+		},
+		{
+			name:  "results while honoring case",
+			args:  args{data: sample, options: []FuzzyOpt{FuzzyIgnoreCase(false)}},
+			value: "BT",
+			want:  []string{},
+		},
+		{
+			name:  "results when filtered short with modified minimum length",
+			args:  args{data: sample, options: []FuzzyOpt{FuzzyMin(3)}},
+			value: "bt",
+			want:  []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Fuzzy(tt.args.data, tt.args.options...)(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Fuzzy() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+package textarea
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textarea"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jimschubert/answer/colors"
+	"github.com/jimschubert/answer/internal/render"
+	"github.com/jimschubert/answer/outcome"
+	"github.com/jimschubert/answer/validate"
+)
+
+var (
+	_ tea.Model = (*Model)(nil)
+)
+
+// ValidateFunc determines if the entire text is valid, returning nil if valid or an error if invalid
+type ValidateFunc validate.Func
+
+// Styles holds relevant styles used for rendering
+// For an introduction to styling with Lip Gloss see:
+// https://github.com/charmbracelet/lipgloss
+type Styles struct {
+	PromptPrefix lipgloss.Style
+	Prompt       lipgloss.Style
+	ErrorPrefix  lipgloss.Style
+	Text         lipgloss.Style
+	Placeholder  lipgloss.Style
+}
+
+type KeyMap struct {
+	Submit key.Binding
+	Quit   key.Binding
+}
+
+// ShortHelp returns keybindings to be shown in the mini help view. It's part
+// of the key.Map interface.
+func (k KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Submit, k.Quit}
+}
+
+// FullHelp returns keybindings for the expanded help view. It's part of the
+// key.Map interface.
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{k.ShortHelp()}
+}
+
+// DefaultKeyMap submits via ctrl+d, as enter inserts a new line
+var DefaultKeyMap = KeyMap{
+	Submit: key.NewBinding(
+		key.WithKeys(tea.KeyCtrlD.String()),
+		key.WithHelp("ctrl+d", "submit"),
+	),
+	Quit: key.NewBinding(
+		key.WithKeys(tea.KeyEsc.String(), tea.KeyCtrlC.String()),
+		key.WithHelp("esc", "quit"),
+	),
+}
+
+// Model represents the bubble tea model for multi-line text
+type Model struct {
+	PromptPrefix    string
+	Prompt          string
+	Placeholder     string
+	CharLimit       int
+	MaxLines        int
+	Width           int
+	Height          int
+	ShowLineNumbers bool
+	HideHelp        bool
+	Validate        ValidateFunc
+	Styles          Styles
+	KeyMap          KeyMap
+	err             error
+	outcome         outcome.Outcome
+	input           textarea.Model
+	help            help.Model
+	initialized     bool
+}
+
+// New creates a new model with default settings.
+func New() Model {
+	return Model{
+		PromptPrefix: "? ",
+		Width:        60,
+		Height:       6,
+		KeyMap:       DefaultKeyMap,
+		Styles: Styles{
+			PromptPrefix: lipgloss.NewStyle().Foreground(lipgloss.Color(colors.PromptPrefix)),
+			ErrorPrefix:  lipgloss.NewStyle().Foreground(lipgloss.Color(colors.ErrorPrefix)),
+			Placeholder:  lipgloss.NewStyle().Foreground(lipgloss.Color(colors.Placeholder)),
+		},
+		help: help.New(),
+	}
+}
+
+func (m *Model) setup() {
+	if m.Validate == nil {
+		m.Validate = ValidateFunc(validate.NewValidation())
+	}
+	if m.Prompt == "" {
+		m.Prompt = "Please enter:"
+	}
+	input := textarea.New()
+	input.CharLimit = m.CharLimit
+	input.MaxHeight = m.MaxLines
+	input.ShowLineNumbers = m.ShowLineNumbers
+	input.Placeholder = m.Placeholder
+	input.FocusedStyle.Placeholder = m.Styles.Placeholder
+	input.FocusedStyle.Text = m.Styles.Text
+	input.FocusedStyle.CursorLine = m.Styles.Text
+	input.SetWidth(m.Width)
+	input.SetHeight(m.Height)
+	input.Focus()
+	m.input = input
+	m.initialized = true
+}
+
+func (m *Model) Init() tea.Cmd {
+	m.setup()
+	return textarea.Blink
+}
+
+func (m *Model) SetValue(value string) {
+	m.input.SetValue(value)
+}
+
+// Resolve sets the value as though entered by the user, returning the error from Validate, if any. This allows the
+// textarea to be answered without user interaction.
+func (m *Model) Resolve(value string) error {
+	m.SetValue(value)
+	if m.Validate != nil {
+		return m.Validate(value)
+	}
+	return nil
+}
+
+func (m *Model) Value() string {
+	return m.input.Value()
+}
+
+// Reopen allows a submitted model to be edited again, retaining its current value and re-running validation
+func (m *Model) Reopen() {
+	m.outcome = outcome.Pending
+	if m.initialized {
+		m.err = m.Validate(m.input.Value())
+		m.input.Focus()
+	}
+}
+
+// Outcome indicates whether the user has submitted or cancelled the text
+func (m *Model) Outcome() outcome.Outcome {
+	return m.outcome
+}
+
+// Err returns outcome.ErrCancelled if the user cancelled the text
+func (m *Model) Err() error {
+	return m.outcome.Err()
+}
+
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if !m.initialized {
+		m.setup()
+	}
+
+	next := msg
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.help.Width = msg.Width
+	case tea.KeyMsg:
+		if msg.Type == tea.KeyRunes && m.CharLimit > 0 {
+			// the upstream textarea miscounts the space remaining when inserting multiple runes (e.g. pasting)
+			available := m.CharLimit - m.input.Length()
+			if available < 0 {
+				available = 0
+			}
+			if available < len(msg.Runes) {
+				msg.Runes = msg.Runes[:available]
+				next = msg
+			}
+		}
+		switch {
+		case key.Matches(msg, m.KeyMap.Submit):
+			if m.err = m.Validate(m.input.Value()); m.err == nil {
+				m.outcome = outcome.Submitted
+				m.input.Blur()
+				return m, tea.Quit
+			}
+			return m, nil
+		case key.Matches(msg, m.KeyMap.Quit):
+			m.outcome = outcome.Cancelled
+			m.input.Blur()
+			return m, tea.Quit
+		}
+	}
+
+	var cmd tea.Cmd
+	before := m.input.Value()
+	m.input, cmd = m.input.Update(next)
+	if m.input.Value() != before {
+		m.err = m.Validate(m.input.Value())
+	}
+	return m, cmd
+}
+
+func (m *Model) View() string {
+	var b strings.Builder
+	if m.PromptPrefix != "" {
+		b.WriteString(m.Styles.PromptPrefix.Inline(true).Render(m.PromptPrefix))
+		if m.Prompt != "" && !strings.HasSuffix(m.PromptPrefix, " ") {
+			b.WriteRune(' ')
+		}
+	}
+
+	if m.outcome == outcome.Cancelled {
+		return b.String()
+	} else if m.outcome == outcome.Submitted {
+		// show the question + answer just as input does, summarizing any lines beyond the first
+		if m.Prompt != "" {
+			b.WriteString(m.Styles.Prompt.Inline(true).Render(m.Prompt))
+			b.WriteRune(' ')
+		}
+		render.WriteSummary(&b, m.input.Value(), m.Styles.Placeholder)
+		return b.String()
+	}
+
+	b.WriteString(m.Styles.Prompt.Inline(true).Render(m.Prompt))
+	b.WriteRune('\n')
+	b.WriteString(m.input.View())
+	b.WriteRune('\n')
+	if m.err != nil {
+		render.WriteError(&b, m.err, m.Styles.ErrorPrefix, m.Styles.Placeholder)
+	}
+	if !m.HideHelp {
+		b.WriteString(m.help.View(m.KeyMap))
+		b.WriteRune('\n')
+	}
+	return b.String()
+}
@@ -0,0 +1,140 @@
+package textarea
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jimschubert/answer/internal/testutil"
+	"github.com/jimschubert/answer/outcome"
+	"github.com/jimschubert/answer/validate"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestModel() Model {
+	m := New()
+	m.Prompt = "Describe the change:"
+	return m
+}
+
+func TestModel_View(t *testing.T) {
+	enter := testutil.Key(tea.KeyEnter)
+	submit := testutil.Key(tea.KeyCtrlD)
+	tests := []struct {
+		name   string
+		setup  func(m *Model)
+		states []testutil.State
+	}{
+		{
+			name: "textarea",
+			states: []testutil.State{
+				{Name: "displays help"},
+				{Name: "inserts new lines", Inputs: []tea.Msg{testutil.Runes("Fix parser"), enter, enter, testutil.Runes("Details")}},
+				{Name: "summarizes lines on submit", Inputs: []tea.Msg{testutil.Runes("Fix parser"), enter, enter, testutil.Runes("Details"), submit}},
+				{Name: "summarizes a single line", Inputs: []tea.Msg{testutil.Runes("Fix parser"), submit}},
+				{Name: "clears on cancel", Inputs: []tea.Msg{testutil.Runes("Fix parser"), testutil.Key(tea.KeyEsc)}},
+			},
+		},
+		{
+			name: "textarea with placeholder",
+			setup: func(m *Model) {
+				m.Placeholder = "(summary, blank line, details)"
+			},
+			states: []testutil.State{
+				{Name: "displays placeholder"},
+			},
+		},
+		{
+			name: "validatable textarea",
+			setup: func(m *Model) {
+				m.Validate = ValidateFunc(validate.NewValidation().
+					Contains("\n\n", "separate the summary from details with a blank line"))
+			},
+			states: []testutil.State{
+				{Name: "displays validation message", Inputs: []tea.Msg{testutil.Runes("Fix parser"), enter, testutil.Runes("Details"), submit}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		for _, s := range tt.states {
+			t.Run(tt.name+"_"+s.Name, func(t *testing.T) {
+				m := newTestModel()
+				if tt.setup != nil {
+					tt.setup(&m)
+				}
+				testutil.RequireGoldenView(t, &m, s)
+			})
+		}
+	}
+}
+
+func TestModel_Update(t *testing.T) {
+	enter := testutil.Key(tea.KeyEnter)
+	submit := testutil.Key(tea.KeyCtrlD)
+	tests := []struct {
+		name        string
+		setup       func(m *Model)
+		inputs      []tea.KeyMsg
+		want        string
+		wantOutcome outcome.Outcome
+		wantErr     bool
+	}{
+		{
+			name:   "inserts new lines via enter",
+			inputs: []tea.KeyMsg{testutil.Runes("Fix parser"), enter, enter, testutil.Runes("Details")},
+			want:   "Fix parser\n\nDetails",
+		},
+		{
+			name:        "submits via ctrl+d",
+			inputs:      []tea.KeyMsg{testutil.Runes("Fix parser"), enter, enter, testutil.Runes("Details"), submit},
+			want:        "Fix parser\n\nDetails",
+			wantOutcome: outcome.Submitted,
+		},
+		{
+			name: "validates the entire text",
+			setup: func(m *Model) {
+				m.Validate = ValidateFunc(validate.NewValidation().
+					Contains("\n\n", "separate the summary from details with a blank line"))
+			},
+			inputs:  []tea.KeyMsg{testutil.Runes("Fix parser"), enter, testutil.Runes("Details"), submit},
+			want:    "Fix parser\nDetails",
+			wantErr: true,
+		},
+		{
+			name: "limits lines",
+			setup: func(m *Model) {
+				m.MaxLines = 2
+			},
+			inputs: []tea.KeyMsg{testutil.Runes("one"), enter, testutil.Runes("two"), enter, testutil.Runes("three")},
+			want:   "one\ntwothree",
+		},
+		{
+			name: "limits characters",
+			setup: func(m *Model) {
+				m.CharLimit = 5
+			},
+			inputs: []tea.KeyMsg{testutil.Runes("abc"), enter, testutil.Runes("defg")},
+			want:   "abc\nd",
+		},
+		{
+			name:        "cancels via esc",
+			inputs:      []tea.KeyMsg{testutil.Runes("Fix parser"), testutil.Key(tea.KeyEsc)},
+			want:        "Fix parser",
+			wantOutcome: outcome.Cancelled,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newTestModel()
+			if tt.setup != nil {
+				tt.setup(&m)
+			}
+			m.Init()
+			for _, msg := range tt.inputs {
+				m.Update(msg)
+			}
+			assert.Equal(t, tt.want, m.Value())
+			assert.Equal(t, tt.wantOutcome, m.Outcome())
+			assert.Equal(t, tt.wantErr, m.err != nil)
+		})
+	}
+}
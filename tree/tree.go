@@ -0,0 +1,579 @@
+package tree
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/paginator"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/jimschubert/answer/colors"
+	"github.com/jimschubert/answer/internal/render"
+	"github.com/jimschubert/answer/outcome"
+)
+
+var (
+	_ tea.Model = (*Model)(nil)
+)
+
+// Node is an item of the tree, displayed by its Label while representing an underlying Value of any type
+type Node struct {
+	// Label is displayed to the user
+	Label string
+	// Value is the underlying value of the node. When nil, the Label is the value.
+	Value any
+	// Children are the nodes beneath this node
+	Children []*Node
+	// Lazy indicates the children of the node are loaded via Model.LoadChildren when the node is first expanded
+	Lazy bool
+}
+
+// String returns the text form of Value, as returned by Model.SelectedValues
+func (n *Node) String() string {
+	return render.Value(n.Value, n.Label)
+}
+
+// check is the tri-state selection of a node
+type check int
+
+const (
+	unchecked check = iota
+	checked
+	partial
+)
+
+// childrenMsg holds the result of lazily loading the children of a node
+type childrenMsg struct {
+	node     *Node
+	children []*Node
+	err      error
+}
+
+// row is a visible node of the tree
+type row struct {
+	node  *Node
+	depth int
+}
+
+// Styles holds relevant styles used for rendering
+// For an introduction to styling with Lip Gloss see:
+// https://github.com/charmbracelet/lipgloss
+type Styles struct {
+	PromptPrefix      lipgloss.Style
+	Prompt            lipgloss.Style
+	Text              lipgloss.Style
+	SelectedIndicator lipgloss.Style
+	ChooserIndicator  lipgloss.Style
+	ExpandIndicator   lipgloss.Style
+	Placeholder       lipgloss.Style
+	ErrorPrefix       lipgloss.Style
+	ErrorText         lipgloss.Style
+}
+
+// Model represents the bubble tea model for the tree selection
+type Model struct {
+	PromptPrefix       string
+	Prompt             string
+	SelectedIndicator  rune
+	PartialIndicator   rune
+	ChooserIndicator   rune
+	ExpandedIndicator  rune
+	CollapsedIndicator rune
+	Styles             Styles
+	Roots              []*Node
+	KeyMap             KeyMap
+	HideHelp           bool
+	PerPage            int
+	// LoadChildren loads the children of a Lazy node when the node is first expanded. Loading is asynchronous, and
+	// the loaded children are assigned to the node's Children. Children of a selected node are selected once loaded.
+	LoadChildren func(node *Node) ([]*Node, error)
+	cursor       int
+	paginator    paginator.Model
+	help         help.Model
+	initialized  bool
+	selected     map[*Node]struct{}
+	expanded     map[*Node]bool
+	loaded       map[*Node]bool
+	loading      map[*Node]bool
+	parents      map[*Node]*Node
+	rows         []row
+	outcome      outcome.Outcome
+	err          error
+}
+
+type KeyMap struct {
+	SelectionUp   key.Binding
+	SelectionDown key.Binding
+	PageNext      key.Binding
+	PagePrev      key.Binding
+	Expand        key.Binding
+	Collapse      key.Binding
+	Quit          key.Binding
+	Select        key.Binding
+	Help          key.Binding
+	Enter         key.Binding
+	ToggleAll     key.Binding
+}
+
+// ShortHelp returns keybindings to be shown in the mini help view. It's part
+// of the key.Map interface.
+func (k KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Help, k.Quit}
+}
+
+// FullHelp returns keybindings for the expanded help view. It's part of the
+// key.Map interface.
+func (k KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.SelectionUp, k.SelectionDown, k.Select},
+		{k.Expand, k.Collapse, k.ToggleAll},
+		{k.PagePrev, k.PageNext},
+		{k.Help, k.Quit},
+	}
+}
+
+var DefaultKeyMap = KeyMap{
+	SelectionUp: key.NewBinding(
+		key.WithKeys("k", tea.KeyUp.String()),
+		key.WithHelp("↑/k", "up"),
+	),
+	SelectionDown: key.NewBinding(
+		key.WithKeys("j", tea.KeyDown.String()),
+		key.WithHelp("↓/j", "down"),
+	),
+	PagePrev: key.NewBinding(
+		key.WithKeys(tea.KeyPgUp.String()),
+		key.WithHelp("pgup", "prev"),
+	),
+	PageNext: key.NewBinding(
+		key.WithKeys(tea.KeyPgDown.String()),
+		key.WithHelp("pgdown", "next"),
+	),
+	Expand: key.NewBinding(
+		key.WithKeys("l", tea.KeyRight.String()),
+		key.WithHelp("→/l", "expand"),
+	),
+	Collapse: key.NewBinding(
+		key.WithKeys("h", tea.KeyLeft.String()),
+		key.WithHelp("←/h", "collapse"),
+	),
+	Quit: key.NewBinding(
+		key.WithKeys("q", tea.KeyEsc.String(), tea.KeyCtrlC.String()),
+		key.WithHelp("q", "quit"),
+	),
+	Select: key.NewBinding(
+		key.WithKeys(tea.KeySpace.String()),
+		key.WithHelp("space", "select"),
+	),
+	Enter: key.NewBinding(key.WithKeys(tea.KeyEnter.String())),
+	Help: key.NewBinding(
+		key.WithKeys("?"),
+		key.WithHelp("?", "help"),
+	),
+	ToggleAll: key.NewBinding(
+		key.WithKeys(tea.KeyTab.String()),
+		key.WithHelp("tab", "all/none"),
+	),
+}
+
+// New creates a new model with default settings.
+func New() Model {
+	return Model{
+		PromptPrefix:       "? ",
+		KeyMap:             DefaultKeyMap,
+		SelectedIndicator:  'x',
+		PartialIndicator:   '~',
+		ChooserIndicator:   '➤',
+		ExpandedIndicator:  '▾',
+		CollapsedIndicator: '▸',
+		Styles: Styles{
+			PromptPrefix:      lipgloss.NewStyle().Foreground(lipgloss.Color(colors.PromptPrefix)),
+			SelectedIndicator: lipgloss.NewStyle().Foreground(lipgloss.Color(colors.PromptPrefix)),
+			ChooserIndicator:  lipgloss.NewStyle().Foreground(lipgloss.Color(colors.PromptPrefix)),
+			ExpandIndicator:   lipgloss.NewStyle().Foreground(lipgloss.Color(colors.Placeholder)),
+			Placeholder:       lipgloss.NewStyle().Foreground(lipgloss.Color(colors.Placeholder)),
+			ErrorPrefix:       lipgloss.NewStyle().Foreground(lipgloss.Color(colors.ErrorPrefix)),
+			ErrorText:         lipgloss.NewStyle().Foreground(lipgloss.Color(colors.Placeholder)),
+		},
+		help:     help.New(),
+		selected: make(map[*Node]struct{}),
+		expanded: make(map[*Node]bool),
+		loaded:   make(map[*Node]bool),
+		loading:  make(map[*Node]bool),
+	}
+}
+
+func (m *Model) setup() {
+	if m.Prompt == "" {
+		m.Prompt = "Please select:"
+	}
+
+	paginate := paginator.New()
+	paginate.Type = paginator.Dots
+	if m.PerPage < 1 {
+		paginate.PerPage = 10
+	} else {
+		paginate.PerPage = m.PerPage
+	}
+	paginate.ActiveDot = lipgloss.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "235", Dark: "252"}).Render("•")
+	paginate.InactiveDot = lipgloss.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "250", Dark: "238"}).Render("•")
+	m.paginator = paginate
+	m.refresh()
+
+	m.initialized = true
+}
+
+// refresh determines the visible rows from the expanded nodes, keeping the cursor within the rows
+func (m *Model) refresh() {
+	m.rows = m.rows[:0]
+	m.parents = make(map[*Node]*Node)
+	var walk func(nodes []*Node, parent *Node, depth int)
+	walk = func(nodes []*Node, parent *Node, depth int) {
+		for _, node := range nodes {
+			m.parents[node] = parent
+			m.rows = append(m.rows, row{node: node, depth: depth})
+			if m.expanded[node] {
+				walk(node.Children, node, depth+1)
+			}
+		}
+	}
+	walk(m.Roots, nil, 0)
+
+	if m.cursor >= len(m.rows) {
+		m.cursor = len(m.rows) - 1
+	}
+	if m.cursor < 0 {
+		m.cursor = 0
+	}
+	if len(m.rows) == 0 {
+		m.paginator.TotalPages = 1
+	} else {
+		m.paginator.SetTotalPages(len(m.rows))
+	}
+	m.paginator.Page = m.cursor / m.paginator.PerPage
+}
+
+// moveTo moves the cursor to the row at index, changing the page as necessary
+func (m *Model) moveTo(index int) {
+	if index < 0 || index >= len(m.rows) {
+		return
+	}
+	m.cursor = index
+	m.paginator.Page = index / m.paginator.PerPage
+}
+
+// current returns the node under the cursor, or nil when the tree is empty
+func (m *Model) current() *Node {
+	if m.cursor >= len(m.rows) {
+		return nil
+	}
+	return m.rows[m.cursor].node
+}
+
+// expandable determines whether the node has, or may lazily load, children
+func (m *Model) expandable(node *Node) bool {
+	return len(node.Children) > 0 || (node.Lazy && !m.loaded[node] && m.LoadChildren != nil)
+}
+
+// expand shows the children of node, loading them first if necessary
+func (m *Model) expand(node *Node) tea.Cmd {
+	if len(node.Children) == 0 && node.Lazy && !m.loaded[node] && m.LoadChildren != nil {
+		if m.loading[node] {
+			return nil
+		}
+		m.loading[node] = true
+		load := m.LoadChildren
+		return func() tea.Msg {
+			children, err := load(node)
+			return childrenMsg{node: node, children: children, err: err}
+		}
+	}
+	if len(node.Children) > 0 {
+		m.expanded[node] = true
+		m.refresh()
+	}
+	return nil
+}
+
+// collapse hides the children of node, or moves to the parent of node when it is not expanded
+func (m *Model) collapse(node *Node) {
+	if m.expanded[node] {
+		m.expanded[node] = false
+		m.refresh()
+		return
+	}
+	if parent := m.parents[node]; parent != nil {
+		for i, r := range m.rows {
+			if r.node == parent {
+				m.moveTo(i)
+				return
+			}
+		}
+	}
+}
+
+// state determines the tri-state selection of node from the selection of its descendants
+func (m *Model) state(node *Node) check {
+	if len(node.Children) == 0 {
+		if _, ok := m.selected[node]; ok {
+			return checked
+		}
+		return unchecked
+	}
+
+	var all, none = true, true
+	for _, child := range node.Children {
+		switch m.state(child) {
+		case checked:
+			none = false
+		case unchecked:
+			all = false
+		case partial:
+			return partial
+		}
+	}
+	switch {
+	case all:
+		return checked
+	case none:
+		return unchecked
+	default:
+		return partial
+	}
+}
+
+// setAll selects or deselects node and all of its descendants
+func (m *Model) setAll(node *Node, selected bool) {
+	if selected {
+		m.selected[node] = struct{}{}
+	} else {
+		delete(m.selected, node)
+	}
+	for _, child := range node.Children {
+		m.setAll(child, selected)
+	}
+}
+
+// toggle selects node and its descendants, or deselects them if all are already selected
+func (m *Model) toggle(node *Node) {
+	m.setAll(node, m.state(node) != checked)
+}
+
+func (m *Model) Init() tea.Cmd {
+	m.setup()
+	return nil
+}
+
+func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if !m.initialized {
+		m.setup()
+	}
+
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		// If we set a width on the help menu it can gracefully truncate its view as needed.
+		m.help.Width = msg.Width
+	case childrenMsg:
+		delete(m.loading, msg.node)
+		if msg.err != nil {
+			m.err = fmt.Errorf("unable to load %s: %w", msg.node.Label, msg.err)
+			break
+		}
+		m.err = nil
+		m.loaded[msg.node] = true
+		msg.node.Children = msg.children
+		if _, ok := m.selected[msg.node]; ok {
+			m.setAll(msg.node, true)
+		}
+		m.expanded[msg.node] = len(msg.children) > 0
+		m.refresh()
+	case tea.KeyMsg:
+		node := m.current()
+		switch {
+		case key.Matches(msg, m.KeyMap.Quit):
+			m.outcome = outcome.Cancelled
+			return m, tea.Quit
+		case key.Matches(msg, m.KeyMap.Enter):
+			m.outcome = outcome.Submitted
+			return m, tea.Quit
+		case key.Matches(msg, m.KeyMap.SelectionUp):
+			m.moveTo(m.cursor - 1)
+		case key.Matches(msg, m.KeyMap.SelectionDown):
+			m.moveTo(m.cursor + 1)
+		case key.Matches(msg, m.KeyMap.PagePrev):
+			if m.paginator.Page > 0 {
+				m.moveTo((m.paginator.Page - 1) * m.paginator.PerPage)
+			}
+		case key.Matches(msg, m.KeyMap.PageNext):
+			m.moveTo((m.paginator.Page + 1) * m.paginator.PerPage)
+		case key.Matches(msg, m.KeyMap.Help):
+			m.help.ShowAll = !m.help.ShowAll
+		case node == nil:
+			break
+		case key.Matches(msg, m.KeyMap.Expand):
+			return m, m.expand(node)
+		case key.Matches(msg, m.KeyMap.Collapse):
+			m.collapse(node)
+		case key.Matches(msg, m.KeyMap.Select):
+			m.toggle(node)
+		case key.Matches(msg, m.KeyMap.ToggleAll):
+			all := true
+			for _, root := range m.Roots {
+				all = all && m.state(root) == checked
+			}
+			for _, root := range m.Roots {
+				m.setAll(root, !all)
+			}
+		}
+	}
+
+	return m, nil
+}
+
+// Reopen allows a submitted model to be edited again, retaining its current page, cursor, expansion and selections
+func (m *Model) Reopen() {
+	m.outcome = outcome.Pending
+}
+
+// Outcome indicates whether the user has submitted or cancelled the selection
+func (m *Model) Outcome() outcome.Outcome {
+	return m.outcome
+}
+
+// Err returns outcome.ErrCancelled if the user cancelled the selection
+func (m *Model) Err() error {
+	return m.outcome.Err()
+}
+
+// SelectValues replaces any current selections with the nodes matching values, by either value or label.
+// Selecting a node selects all of its descendants. Only nodes which have been loaded may be selected.
+// An error is returned, leaving selections unmodified, if any value is not a node of the tree.
+func (m *Model) SelectValues(values ...string) error {
+	selected := m.selected
+	m.selected = make(map[*Node]struct{})
+	missing := make([]string, 0)
+	for _, value := range values {
+		if node := find(m.Roots, value); node != nil {
+			m.setAll(node, true)
+		} else {
+			missing = append(missing, fmt.Sprintf("%q", value))
+		}
+	}
+	if len(missing) > 0 {
+		m.selected = selected
+		return fmt.Errorf("invalid choice %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// find returns the first node, searching depth-first, whose value or label is value
+func find(nodes []*Node, value string) *Node {
+	for _, node := range nodes {
+		if node.String() == value || node.Label == value {
+			return node
+		}
+		if found := find(node.Children, value); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// SelectedNodes returns the selected leaf nodes in tree order. A selected node whose lazy children have not been
+// loaded is considered a leaf.
+func (m *Model) SelectedNodes() []*Node {
+	nodes := make([]*Node, 0)
+	var walk func(nodes []*Node)
+	walk = func(children []*Node) {
+		for _, node := range children {
+			if len(node.Children) > 0 {
+				walk(node.Children)
+			} else if _, ok := m.selected[node]; ok {
+				nodes = append(nodes, node)
+			}
+		}
+	}
+	walk(m.Roots)
+	return nodes
+}
+
+// SelectedValues returns the text of each selected leaf node's value. See Node.String.
+func (m *Model) SelectedValues() []string {
+	values := make([]string, 0)
+	for _, node := range m.SelectedNodes() {
+		values = append(values, node.String())
+	}
+	return values
+}
+
+func (m *Model) View() string {
+	styleText := m.Styles.Text.Inline(true).Render
+
+	var b strings.Builder
+	b.WriteString(m.Styles.PromptPrefix.Inline(true).Render(m.PromptPrefix))
+	if !strings.HasSuffix(m.PromptPrefix, " ") {
+		b.WriteString(" ")
+	}
+	b.WriteString(m.Styles.Prompt.Render(m.Prompt))
+	b.WriteString("\n\n")
+
+	start, end := m.paginator.GetSliceBounds(len(m.rows))
+	for i, r := range m.rows[start:end] {
+		cursor := " "
+		if m.cursor == start+i {
+			cursor = m.Styles.ChooserIndicator.Inline(true).Render(string(m.ChooserIndicator))
+		}
+		b.WriteString(cursor)
+		b.WriteString(" ")
+		indent := strings.Repeat("  ", r.depth)
+		b.WriteString(indent)
+
+		switch {
+		case m.expanded[r.node]:
+			b.WriteString(m.Styles.ExpandIndicator.Inline(true).Render(string(m.ExpandedIndicator)))
+		case m.expandable(r.node):
+			b.WriteString(m.Styles.ExpandIndicator.Inline(true).Render(string(m.CollapsedIndicator)))
+		default:
+			b.WriteString(" ")
+		}
+		b.WriteString(" ")
+
+		b.WriteString(styleText("["))
+		switch m.state(r.node) {
+		case checked:
+			b.WriteString(m.Styles.SelectedIndicator.Inline(true).Render(string(m.SelectedIndicator)))
+		case partial:
+			b.WriteString(m.Styles.SelectedIndicator.Inline(true).Render(string(m.PartialIndicator)))
+		case unchecked:
+			b.WriteString(" ")
+		}
+		b.WriteString(styleText("] "))
+		b.WriteString(styleText(r.node.Label))
+		b.WriteString("\n")
+
+		if m.loading[r.node] {
+			b.WriteString("    ")
+			b.WriteString(indent)
+			b.WriteString(m.Styles.Placeholder.Inline(true).Render("loading…"))
+			b.WriteString("\n")
+		}
+	}
+	if m.paginator.TotalPages > 1 {
+		b.WriteString("  " + m.paginator.View())
+	}
+	if m.err != nil && m.outcome == outcome.Pending {
+		if m.paginator.TotalPages > 1 {
+			b.WriteString("\n")
+		}
+		render.WriteError(&b, m.err, m.Styles.ErrorPrefix, m.Styles.ErrorText)
+	}
+	if !m.HideHelp && m.outcome == outcome.Pending {
+		helpView := m.help.View(m.KeyMap)
+		b.WriteString("\n\n")
+		b.WriteString(helpView)
+	}
+	b.WriteString("\n")
+	return b.String()
+}
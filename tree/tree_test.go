@@ -0,0 +1,233 @@
+package tree
+
+import (
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/jimschubert/answer/internal/testutil"
+	"github.com/jimschubert/answer/outcome"
+	"github.com/jimschubert/stripansi"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRoots() []*Node {
+	return []*Node{
+		{Label: "cmd", Children: []*Node{
+			{Label: "app", Children: []*Node{{Label: "main.go", Value: "cmd/app/main.go"}}},
+			{Label: "tool.go", Value: "cmd/tool.go"},
+		}},
+		{Label: "pkg", Lazy: true},
+		{Label: "README.md"},
+	}
+}
+
+func TestModel_View(t *testing.T) {
+	space := testutil.Key(tea.KeySpace)
+	down := testutil.Key(tea.KeyDown)
+	left := testutil.Key(tea.KeyLeft)
+	right := testutil.Key(tea.KeyRight)
+	loaded := func(node *Node) ([]*Node, error) {
+		return []*Node{{Label: "a.go"}, {Label: "b.go"}}, nil
+	}
+	tests := []struct {
+		name    string
+		perPage int
+		load    func(node *Node) ([]*Node, error)
+		states  []testutil.State
+	}{
+		{
+			name: "tree",
+			load: loaded,
+			states: []testutil.State{
+				{Name: "displays roots and help"},
+				{Name: "selects all children of a parent", Inputs: []tea.Msg{space}},
+				{Name: "displays partially selected parents", Inputs: []tea.Msg{right, down, space}},
+				{Name: "collapses expanded nodes", Inputs: []tea.Msg{right, left}},
+				{Name: "moves to parent on collapse", Inputs: []tea.Msg{right, down, right, down, left, left, left, space}},
+				{Name: "loads children when first expanded", Inputs: []tea.Msg{down, right}, WaitFor: "b.go"},
+				{Name: "selects loaded children of a selected node", Inputs: []tea.Msg{down, space, right}, WaitFor: "b.go"},
+				{Name: "displays selection on submit", Inputs: []tea.Msg{down, down, space, testutil.Key(tea.KeyEnter)}},
+			},
+		},
+		{
+			name: "tree with failed load",
+			load: func(node *Node) ([]*Node, error) {
+				return nil, errors.New("permission denied")
+			},
+			states: []testutil.State{
+				{Name: "displays load failure", Inputs: []tea.Msg{down, right}, WaitFor: "permission denied"},
+			},
+		},
+		{
+			name:    "paginated tree",
+			perPage: 2,
+			load:    loaded,
+			states: []testutil.State{
+				{Name: "displays first page"},
+				{Name: "moves across pages", Inputs: []tea.Msg{down, down, space}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		for _, s := range tt.states {
+			t.Run(tt.name+"_"+s.Name, func(t *testing.T) {
+				m := New()
+				m.Roots = newRoots()
+				m.PerPage = tt.perPage
+				m.LoadChildren = tt.load
+				testutil.RequireGoldenView(t, &m, s)
+			})
+		}
+	}
+}
+
+func TestModel_Update(t *testing.T) {
+	space := testutil.Key(tea.KeySpace)
+	up := testutil.Key(tea.KeyUp)
+	down := testutil.Key(tea.KeyDown)
+	left := testutil.Key(tea.KeyLeft)
+	right := testutil.Key(tea.KeyRight)
+	tests := []struct {
+		name        string
+		perPage     int
+		inputs      []tea.KeyMsg
+		want        []string
+		wantOutcome outcome.Outcome
+	}{
+		{
+			name:   "selecting a parent selects all children",
+			inputs: []tea.KeyMsg{space},
+			want:   []string{"cmd/app/main.go", "cmd/tool.go"},
+		},
+		{
+			name:   "partially selected parents show tri-state",
+			inputs: []tea.KeyMsg{right, down, space},
+			want:   []string{"cmd/app/main.go"},
+		},
+		{
+			name:   "collapses expanded nodes",
+			inputs: []tea.KeyMsg{right, left},
+			want:   []string{},
+		},
+		{
+			name:   "collapse moves to parent",
+			inputs: []tea.KeyMsg{right, down, right, down, left, left, left, space},
+			want:   []string{"cmd/app/main.go", "cmd/tool.go"},
+		},
+		{
+			name:   "toggles all nodes",
+			inputs: []tea.KeyMsg{testutil.Key(tea.KeyTab)},
+			want:   []string{"cmd/app/main.go", "cmd/tool.go", "pkg", "README.md"},
+		},
+		{
+			name:   "deselects all nodes when all are selected",
+			inputs: []tea.KeyMsg{testutil.Key(tea.KeyTab), testutil.Key(tea.KeyTab)},
+			want:   []string{},
+		},
+		{
+			name:    "moves across pages",
+			perPage: 2,
+			inputs:  []tea.KeyMsg{down, down, space},
+			want:    []string{"README.md"},
+		},
+		{
+			name:    "moves to previous page",
+			perPage: 2,
+			inputs:  []tea.KeyMsg{testutil.Key(tea.KeyPgDown), up, space},
+			want:    []string{"pkg"},
+		},
+		{
+			name:        "submits via enter",
+			inputs:      []tea.KeyMsg{down, down, space, testutil.Key(tea.KeyEnter)},
+			want:        []string{"README.md"},
+			wantOutcome: outcome.Submitted,
+		},
+		{
+			name:        "cancels via esc",
+			inputs:      []tea.KeyMsg{testutil.Key(tea.KeyEsc)},
+			want:        []string{},
+			wantOutcome: outcome.Cancelled,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.Roots = newRoots()
+			m.PerPage = tt.perPage
+			m.LoadChildren = func(node *Node) ([]*Node, error) {
+				return nil, nil
+			}
+			m.Init()
+			for _, msg := range tt.inputs {
+				m.Update(msg)
+			}
+			assert.Equal(t, tt.want, m.SelectedValues())
+			assert.Equal(t, tt.wantOutcome, m.Outcome())
+		})
+	}
+}
+
+func TestModel_LoadChildren(t *testing.T) {
+	down := testutil.Key(tea.KeyDown)
+	right := testutil.Key(tea.KeyRight)
+	space := testutil.Key(tea.KeySpace)
+	tests := []struct {
+		name   string
+		load   func(node *Node) ([]*Node, error)
+		inputs []tea.KeyMsg
+		want   []string
+	}{
+		{
+			name: "loads children when first expanded",
+			load: func(node *Node) ([]*Node, error) {
+				return []*Node{{Label: "a.go"}, {Label: "b.go"}}, nil
+			},
+			inputs: []tea.KeyMsg{down, right},
+			want:   []string{},
+		},
+		{
+			name: "selects loaded children of a selected node",
+			load: func(node *Node) ([]*Node, error) {
+				return []*Node{{Label: "a.go"}, {Label: "b.go"}}, nil
+			},
+			inputs: []tea.KeyMsg{down, space, right},
+			want:   []string{"a.go", "b.go"},
+		},
+		{
+			name: "reports load failures",
+			load: func(node *Node) ([]*Node, error) {
+				return nil, errors.New("permission denied")
+			},
+			inputs: []tea.KeyMsg{down, right},
+			want:   []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := New()
+			m.Roots = newRoots()
+			m.LoadChildren = tt.load
+			m.Init()
+			for _, msg := range tt.inputs {
+				if _, cmd := m.Update(msg); cmd != nil {
+					assert.Contains(t, stripansi.String(m.View()), "loading…")
+					m.Update(cmd())
+				}
+			}
+			assert.Equal(t, tt.want, m.SelectedValues())
+		})
+	}
+}
+
+func TestModel_SelectValues(t *testing.T) {
+	m := New()
+	m.Roots = newRoots()
+	m.Init()
+
+	assert.NoError(t, m.SelectValues("app", "README.md"))
+	assert.Equal(t, []string{"cmd/app/main.go", "README.md"}, m.SelectedValues())
+
+	assert.EqualError(t, m.SelectValues("cmd", "missing"), `invalid choice "missing"`)
+	assert.Equal(t, []string{"cmd/app/main.go", "README.md"}, m.SelectedValues())
+}
@@ -1,10 +1,17 @@
 package validate
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
+	"unicode"
+
+	"github.com/jimschubert/answer/internal/paths"
 )
 
 func errMessage(args ...any) string {
@@ -28,6 +35,10 @@ func errMessage(args ...any) string {
 // Func determines if the input string is valid, returning nil if valid or an error if invalid
 type Func func(input string) error
 
+// AsyncFunc determines if the input string is valid like Func, for validations which may be slow (e.g. querying a
+// remote service). Implementations should return promptly once ctx is cancelled.
+type AsyncFunc func(ctx context.Context, input string) error
+
 // MinLength defines the minimum allowed length (in runes)
 func (fn Func) MinLength(length int, msgAndArgs ...any) Func {
 	return func(input string) error {
@@ -103,6 +114,154 @@ func (fn Func) Contains(value string, msgAndArgs ...any) Func {
 	}
 }
 
+// StrengthScore rates the strength of a password from 0 (weakest) to 4 (strongest). A point is awarded for each of
+// lowercase letters, uppercase letters, digits and symbols present. A point is deducted for fewer than 8 runes and one
+// is awarded for 12 or more runes.
+func StrengthScore(input string) int {
+	var lower, upper, digit, symbol bool
+	length := 0
+	for _, r := range input {
+		length++
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		case !unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+
+	score := 0
+	for _, present := range []bool{lower, upper, digit, symbol} {
+		if present {
+			score++
+		}
+	}
+	if length < 8 {
+		score--
+	} else if length >= 12 {
+		score++
+	}
+	if score < 0 {
+		return 0
+	}
+	if score > 4 {
+		return 4
+	}
+	return score
+}
+
+// Strength defines the minimum StrengthScore required for the targeted input
+func (fn Func) Strength(minScore int, msgAndArgs ...any) Func {
+	return func(input string) error {
+		actual := StrengthScore(input)
+		if actual < minScore {
+			if msg := errMessage(msgAndArgs...); msg != "" {
+				return errors.New(msg)
+			}
+			return fmt.Errorf("minimum strength required=%d actual=%d", minScore, actual)
+		}
+		return fn(input)
+	}
+}
+
+// FileExists defines that the targeted input must be the path of an existing file, not a directory. A leading ~ refers
+// to the user's home directory.
+func (fn Func) FileExists(msgAndArgs ...any) Func {
+	return func(input string) error {
+		info, err := os.Stat(paths.Expand(input))
+		if err != nil || info.IsDir() {
+			if msg := errMessage(msgAndArgs...); msg != "" {
+				return errors.New(msg)
+			}
+			if err == nil {
+				return fmt.Errorf("%q is a directory", input)
+			}
+			return fmt.Errorf("file %q does not exist", input)
+		}
+		return fn(input)
+	}
+}
+
+// IsDir defines that the targeted input must be the path of an existing directory. A leading ~ refers to the user's
+// home directory.
+func (fn Func) IsDir(msgAndArgs ...any) Func {
+	return func(input string) error {
+		info, err := os.Stat(paths.Expand(input))
+		if err != nil || !info.IsDir() {
+			if msg := errMessage(msgAndArgs...); msg != "" {
+				return errors.New(msg)
+			}
+			if err == nil {
+				return fmt.Errorf("%q is not a directory", input)
+			}
+			return fmt.Errorf("directory %q does not exist", input)
+		}
+		return fn(input)
+	}
+}
+
+// IsWritable defines that the targeted input must be a path which can be written. A file must be writable, a
+// directory must allow files to be created, and a path which does not yet exist must be in a writable directory.
+// A leading ~ refers to the user's home directory. Permissions are inspected without writing to the filesystem.
+func (fn Func) IsWritable(msgAndArgs ...any) Func {
+	return func(input string) error {
+		if !writable(paths.Expand(input)) {
+			if msg := errMessage(msgAndArgs...); msg != "" {
+				return errors.New(msg)
+			}
+			return fmt.Errorf("%q is not writable", input)
+		}
+		return fn(input)
+	}
+}
+
+func writable(path string) bool {
+	if path == "" {
+		return false
+	}
+	info, err := os.Stat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		parent := filepath.Dir(filepath.Clean(path))
+		if parent == path {
+			return false
+		}
+		return writable(parent)
+	}
+	if err != nil {
+		return false
+	}
+
+	return canWrite(path, info)
+}
+
+// Extension defines the file extensions (e.g. ".yaml") allowed for the targeted input, ignoring case
+func (fn Func) Extension(extensions []string, msgAndArgs ...any) Func {
+	return func(input string) error {
+		if !paths.HasExtension(input, extensions...) {
+			if msg := errMessage(msgAndArgs...); msg != "" {
+				return errors.New(msg)
+			}
+			return fmt.Errorf("%q must have one of the extensions %s", input, strings.Join(extensions, ", "))
+		}
+		return fn(input)
+	}
+}
+
+// Async returns an AsyncFunc which evaluates the chain before check, so that the slower check only runs for input
+// satisfying the chain
+func (fn Func) Async(check AsyncFunc) AsyncFunc {
+	return func(ctx context.Context, input string) error {
+		if err := fn(input); err != nil {
+			return err
+		}
+		return check(ctx, input)
+	}
+}
+
 // Build returns the raw underlying functional type
 func (fn Func) Build() func(string) error {
 	return fn
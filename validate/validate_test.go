@@ -1,7 +1,12 @@
 package validate
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
 	"unicode"
@@ -112,6 +117,24 @@ func TestNewValidation_all(t *testing.T) {
 			validationFn: NewValidation().Contains("quick", "Use standard font test string"),
 			want:         errors.New("Use standard font test string"),
 		},
+		{
+			name:         "Strength() returns no errors for strong input",
+			input:        "correct-Horse-battery-9",
+			validationFn: NewValidation().Strength(4),
+			want:         nil,
+		},
+		{
+			name:         "Strength() returns error for weak input",
+			input:        "password",
+			validationFn: NewValidation().Strength(3),
+			want:         errors.New("minimum strength required=3 actual=1"),
+		},
+		{
+			name:         "Strength() returns custom error for weak input",
+			input:        "Pass1",
+			validationFn: NewValidation().Strength(3, "Use a longer password"),
+			want:         errors.New("Use a longer password"),
+		},
 		{
 			name:         "MinLength().MaxLength() returns no errors for valid input",
 			input:        "asdf",
@@ -301,3 +324,116 @@ func TestNewValidation_all(t *testing.T) {
 		})
 	}
 }
+
+func TestStrengthScore(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int
+	}{
+		{input: "", want: 0},
+		{input: "abc", want: 0},
+		{input: "Abc1", want: 2},
+		{input: "password", want: 1},
+		{input: "Password1", want: 3},
+		{input: "Password1!", want: 4},
+		{input: "passwordpassword", want: 2},
+		{input: "pässwörd-Ünïcode-2", want: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := StrengthScore(tt.input); got != tt.want {
+				t.Errorf("StrengthScore(%q) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPathValidations(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "config.yaml")
+	if err := os.WriteFile(file, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	readOnly := filepath.Join(dir, "read-only")
+	if err := os.Mkdir(readOnly, 0o555); err != nil {
+		t.Fatal(err)
+	}
+	missing := filepath.Join(dir, "missing.yaml")
+	t.Setenv("HOME", dir)
+	t.Setenv("USERPROFILE", dir)
+
+	tests := []struct {
+		name             string
+		input            string
+		validationFn     Func
+		want             string
+		needsPermissions bool
+	}{
+		{name: "FileExists() returns no errors for a file", input: file, validationFn: NewValidation().FileExists()},
+		{name: "FileExists() expands ~", input: "~/config.yaml", validationFn: NewValidation().FileExists()},
+		{name: "FileExists() returns error for a missing file", input: missing, validationFn: NewValidation().FileExists(), want: fmt.Sprintf("file %q does not exist", missing)},
+		{name: "FileExists() returns error for a directory", input: dir, validationFn: NewValidation().FileExists(), want: fmt.Sprintf("%q is a directory", dir)},
+		{name: "FileExists() returns custom error", input: missing, validationFn: NewValidation().FileExists("config not found"), want: "config not found"},
+		{name: "IsDir() returns no errors for a directory", input: dir, validationFn: NewValidation().IsDir()},
+		{name: "IsDir() returns error for a file", input: file, validationFn: NewValidation().IsDir(), want: fmt.Sprintf("%q is not a directory", file)},
+		{name: "IsDir() returns error for a missing directory", input: missing, validationFn: NewValidation().IsDir(), want: fmt.Sprintf("directory %q does not exist", missing)},
+		{name: "IsWritable() returns no errors for a file", input: file, validationFn: NewValidation().IsWritable()},
+		{name: "IsWritable() returns no errors for a directory", input: dir, validationFn: NewValidation().IsWritable()},
+		{name: "IsWritable() returns no errors for a new file in a writable directory", input: missing, validationFn: NewValidation().IsWritable()},
+		{name: "IsWritable() returns error for a read-only directory", input: readOnly, validationFn: NewValidation().IsWritable(), want: fmt.Sprintf("%q is not writable", readOnly), needsPermissions: true},
+		{name: "IsWritable() returns error for a new file in a read-only directory", input: filepath.Join(readOnly, "new.yaml"), validationFn: NewValidation().IsWritable("choose another directory"), want: "choose another directory", needsPermissions: true},
+		{name: "Extension() returns no errors for an allowed extension", input: "config.YML", validationFn: NewValidation().Extension([]string{".yaml", ".yml"})},
+		{name: "Extension() returns error for another extension", input: "config.json", validationFn: NewValidation().Extension([]string{".yaml", ".yml"}), want: `"config.json" must have one of the extensions .yaml, .yml`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.needsPermissions && (runtime.GOOS == "windows" || os.Geteuid() == 0) {
+				t.Skip("file permissions are not enforced")
+			}
+			got := tt.validationFn(tt.input)
+			if tt.want == "" && got != nil {
+				t.Errorf("validations: got %v, want nil", got)
+			} else if tt.want != "" && (got == nil || got.Error() != tt.want) {
+				t.Errorf("validations: got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFunc_Async(t *testing.T) {
+	taken := func(ctx context.Context, input string) error {
+		if input == "jim" {
+			return errors.New("username is taken")
+		}
+		return ctx.Err()
+	}
+	tests := []struct {
+		name  string
+		input string
+		ctx   func() context.Context
+		want  string
+	}{
+		{name: "runs the chain first", input: "j", want: "min: 2 characters"},
+		{name: "runs the check after the chain", input: "jim", want: "username is taken"},
+		{name: "passes valid input", input: "james"},
+		{name: "passes the context to the check", input: "james", ctx: func() context.Context {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			return ctx
+		}, want: context.Canceled.Error()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.ctx != nil {
+				ctx = tt.ctx()
+			}
+			got := NewValidation().MinLength(2, "min: 2 characters").Async(taken)(ctx, tt.input)
+			if tt.want == "" && got != nil {
+				t.Errorf("validations: got %v, want nil", got)
+			} else if tt.want != "" && (got == nil || got.Error() != tt.want) {
+				t.Errorf("validations: got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+//go:build !unix
+
+package validate
+
+import (
+	"io/fs"
+)
+
+// canWrite reports whether path is writable according to its mode bits. On Windows these only reflect the read-only
+// attribute, which doesn't apply to directories, so access control lists aren't considered.
+func canWrite(_ string, info fs.FileInfo) bool {
+	return info.IsDir() || info.Mode().Perm()&0o200 != 0
+}
@@ -0,0 +1,14 @@
+//go:build unix
+
+package validate
+
+import (
+	"io/fs"
+
+	"golang.org/x/sys/unix"
+)
+
+// canWrite reports whether the current user may write to path, according to access(2)
+func canWrite(path string, _ fs.FileInfo) bool {
+	return unix.Access(path, unix.W_OK) == nil
+}